@@ -6,6 +6,10 @@
 // with type parameters.
 package constraints
 
+import (
+	"cmp"
+)
+
 // Signed is a constraint that permits any signed integer type.
 // If future releases of Go add new predeclared signed integer types,
 // this constraint will be modified to include them.
@@ -42,11 +46,10 @@ type Complex interface {
 }
 
 // Ordered is a constraint that permits any ordered type: any type
-// that supports the operators < <= >= >.
-// If future releases of Go add new ordered types,
-// this constraint will be modified to include them.
+// that supports the operators < <= >= >. It is the same set of types as
+// [cmp.Ordered] which should be used to compare the values.
 type Ordered interface {
-	Integer | Float | ~string
+	cmp.Ordered
 }
 
 // Number is a constraint that permits any numerical type.
@@ -4,9 +4,11 @@
 package core
 
 import (
+	"cmp"
 	"reflect"
 	"runtime"
 	"runtime/debug"
+	"strings"
 	"unsafe"
 )
 
@@ -27,9 +29,18 @@ func IsNil(have any) bool {
 
 // Len gets length of x using reflection. Returns (0, false) if impossible.
 //
-// Can be used for: strings, arrays, slices and channels.
+// Can be used for: strings, arrays, slices, channels and iterators. For
+// iterators, it returns the number of yielded items or (0, false) if the
+// iterator yields more than [SeqLimit] items.
 func Len(v any) (length int, ok bool) {
 	vv := reflect.ValueOf(v)
+	if SeqArity(reflect.TypeOf(v)) > 0 {
+		_, vals, more := Collect(vv, SeqLimit)
+		if more {
+			return 0, false
+		}
+		return len(vals), true
+	}
 	defer func() {
 		if e := recover(); e != nil {
 			ok = false
@@ -131,3 +142,81 @@ func same(want, have reflect.Value) bool {
 	hPtr := unsafe.Pointer(have.Pointer())
 	return wPtr == hPtr
 }
+
+// ValueCmp compares two values returning -1, 0 or +1. It is used to order map
+// keys in a stable way. Interface values are compared by the values they
+// hold. Values of different kinds are ordered by kind, values of different
+// types of the same kind are ordered by type name.
+//
+// nolint: cyclop
+func ValueCmp(a, b reflect.Value) int {
+	if a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+	if b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+	if ak, bk := a.Kind(), b.Kind(); ak != bk {
+		return cmp.Compare(ak, bk)
+	}
+	if !a.IsValid() {
+		return 0
+	}
+	if at, bt := a.Type().String(), b.Type().String(); at != bt {
+		return strings.Compare(at, bt)
+	}
+
+	switch a.Kind() {
+	case reflect.Bool:
+		av, bv := a.Bool(), b.Bool()
+		if av == bv {
+			return 0
+		}
+		if av {
+			return 1
+		}
+		return -1
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		av, bv := a.Int(), b.Int()
+		if av == bv {
+			return 0
+		}
+		if av < bv {
+			return -1
+		}
+		return 1
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+
+		av, bv := a.Uint(), b.Uint()
+		if av == bv {
+			return 0
+		}
+		if av < bv {
+			return -1
+		}
+		return 1
+
+	case reflect.Float32, reflect.Float64:
+		av, bv := a.Float(), b.Float()
+		if av == bv {
+			return 0
+		}
+		if av < bv {
+			return -1
+		}
+		return 1
+
+	default:
+		av, bv := a.String(), b.String()
+		if av == bv {
+			return 0
+		}
+		if av < bv {
+			return -1
+		}
+		return 1
+	}
+}
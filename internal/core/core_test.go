@@ -4,7 +4,10 @@
 package core
 
 import (
+	"iter"
+	"maps"
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/ctx42/testing/internal/cases"
@@ -35,6 +38,12 @@ func Test_Len_tabular(t *testing.T) {
 	ch <- 0
 	t.Cleanup(func() { <-ch; close(ch) })
 
+	var nilSeq iter.Seq[int]
+	infSeq := func(yield func(int) bool) {
+		for yield(0) {
+		}
+	}
+
 	tt := []struct {
 		testN string
 
@@ -46,8 +55,13 @@ func Test_Len_tabular(t *testing.T) {
 		{"success slice", []int{1, 2}, 2, true},
 		{"success array", [...]int{1, 2}, 2, true},
 		{"success channel", ch, 1, true},
+		{"success iter.Seq", slices.Values([]int{1, 2}), 2, true},
+		{"success iter.Seq2", maps.All(map[int]int{1: 1}), 1, true},
+		{"success nil iter.Seq", nilSeq, 0, true},
 
 		{"error not supported", 123, 0, false},
+		{"error nil", nil, 0, false},
+		{"error iterator over limit", infSeq, 0, false},
 	}
 
 	for _, tc := range tt {
@@ -242,3 +256,157 @@ func Test_Same_tabular(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValueCmp_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		a    any
+		b    any
+		want int
+	}{
+		{"bool false & false", false, false, 0},
+		{"bool true & true", true, true, 0},
+		{"bool true & false", true, false, 1},
+		{"bool false & true", false, true, -1},
+
+		{"int 0 & 0", 0, 0, 0},
+		{"int 1 & 1", 0, 0, 0},
+		{"int 2 & 1", 2, 1, 1},
+		{"int 1 & 2", 1, 2, -1},
+
+		{"int8 0 & 0", int8(0), int8(0), 0},
+		{"int8 1 & 1", int8(0), int8(0), 0},
+		{"int8 2 & 1", int8(2), int8(1), 1},
+		{"int8 1 & 2", int8(1), int8(2), -1},
+
+		{"int16 0 & 0", int16(0), int16(0), 0},
+		{"int16 1 & 1", int16(0), int16(0), 0},
+		{"int16 2 & 1", int16(2), int16(1), 1},
+		{"int16 1 & 2", int16(1), int16(2), -1},
+
+		{"int32 0 & 0", int32(0), int32(0), 0},
+		{"int32 1 & 1", int32(0), int32(0), 0},
+		{"int32 2 & 1", int32(2), int32(1), 1},
+		{"int32 1 & 2", int32(1), int32(2), -1},
+
+		{"int64 0 & 0", int64(0), int64(0), 0},
+		{"int64 1 & 1", int64(0), int64(0), 0},
+		{"int64 2 & 1", int64(2), int64(1), 1},
+		{"int64 1 & 2", int64(1), int64(2), -1},
+
+		{"uint 0 & 0", uint(0), uint(0), 0},
+		{"uint 1 & 1", uint(0), uint(0), 0},
+		{"uint 2 & 1", uint(2), uint(1), 1},
+		{"uint 1 & 2", uint(1), uint(2), -1},
+
+		{"uint8 0 & 0", uint8(0), uint8(0), 0},
+		{"uint8 1 & 1", uint8(0), uint8(0), 0},
+		{"uint8 2 & 1", uint8(2), uint8(1), 1},
+		{"uint8 1 & 2", uint8(1), uint8(2), -1},
+
+		{"uint16 0 & 0", uint16(0), uint16(0), 0},
+		{"uint16 1 & 1", uint16(0), uint16(0), 0},
+		{"uint16 2 & 1", uint16(2), uint16(1), 1},
+		{"uint16 1 & 2", uint16(1), uint16(2), -1},
+
+		{"uint32 0 & 0", uint32(0), uint32(0), 0},
+		{"uint32 1 & 1", uint32(0), uint32(0), 0},
+		{"uint32 2 & 1", uint32(2), uint32(1), 1},
+		{"uint32 1 & 2", uint32(1), uint32(2), -1},
+
+		{"uint64 0 & 0", uint64(0), uint64(0), 0},
+		{"uint64 1 & 1", uint64(0), uint64(0), 0},
+		{"uint64 2 & 1", uint64(2), uint64(1), 1},
+		{"uint64 1 & 2", uint64(1), uint64(2), -1},
+
+		{"uintptr 0 & 0", uintptr(0), uintptr(0), 0},
+		{"uintptr 1 & 1", uintptr(0), uintptr(0), 0},
+		{"uintptr 2 & 1", uintptr(2), uintptr(1), 1},
+		{"uintptr 1 & 2", uintptr(1), uintptr(2), -1},
+
+		{"float32 0 & 0", float32(0.0), float32(0.0), 0},
+		{"float32 1 & 1", float32(0.0), float32(0.0), 0},
+		{"float32 2 & 1", float32(2.0), float32(1.0), 1},
+		{"float32 1 & 2", float32(1.0), float32(2.0), -1},
+
+		{"float64 0 & 0", 0.0, 0.0, 0},
+		{"float64 1 & 1", 0.0, 0.0, 0},
+		{"float64 2 & 1", 2.0, 1.0, 1},
+		{"float64 1 & 2", 1.0, 2.0, -1},
+
+		{"string empty & empty", "", "", 0},
+		{"string abc & abc", "abc", "abc", 0},
+		{"string xyz & abc", "xyz", "abc", 1},
+		{"string abc & xyz", "abc", "xyz", -1},
+
+		{"kind int & string", 1, "a", -1},
+		{"kind string & int", "a", 1, 1},
+		{"kind bool & float64", true, 1.5, -1},
+		{"type int & named int", 2, types.TIntType(1), -1},
+		{"type named int & int", types.TIntType(1), 2, 1},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			a := reflect.ValueOf(tc.a)
+			b := reflect.ValueOf(tc.b)
+
+			// --- When ---
+			have := ValueCmp(a, b)
+
+			// --- Then ---
+			if tc.want != have {
+				t.Errorf("expected %d, got %d", tc.want, have)
+			}
+		})
+	}
+}
+
+func Test_ValueCmp(t *testing.T) {
+	t.Run("interface values", func(t *testing.T) {
+		// --- Given ---
+		vals := reflect.ValueOf([]any{"b", 2, "a", 1})
+		a, b := vals.Index(0), vals.Index(2)
+
+		// --- When ---
+		have := ValueCmp(a, b)
+
+		// --- Then ---
+		if have != 1 {
+			t.Errorf("expected 1, got %d", have)
+		}
+	})
+
+	t.Run("interface values of different kinds", func(t *testing.T) {
+		// --- Given ---
+		vals := reflect.ValueOf([]any{"b", 2})
+		a, b := vals.Index(0), vals.Index(1)
+
+		// --- When ---
+		have := ValueCmp(a, b)
+
+		// --- Then ---
+		if have != 1 {
+			t.Errorf("expected 1, got %d", have)
+		}
+	})
+
+	t.Run("nil interface values", func(t *testing.T) {
+		// --- Given ---
+		vals := reflect.ValueOf([]any{nil, nil, 1})
+
+		// --- When ---
+		have0 := ValueCmp(vals.Index(0), vals.Index(1))
+		have1 := ValueCmp(vals.Index(0), vals.Index(2))
+
+		// --- Then ---
+		if have0 != 0 {
+			t.Errorf("expected 0, got %d", have0)
+		}
+		if have1 != -1 {
+			t.Errorf("expected -1, got %d", have1)
+		}
+	})
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package core
+
+import (
+	"reflect"
+)
+
+// SeqLimit is the maximum number of items collected from an iterator. It
+// protects against infinite iterators.
+const SeqLimit = 1_000
+
+// SeqArity returns the number of values yielded by the function type with
+// [iter.Seq] (1) or [iter.Seq2] (2) signature. Returns 0 for other types.
+func SeqArity(typ reflect.Type) int {
+	if typ == nil || typ.Kind() != reflect.Func {
+		return 0
+	}
+	if typ.NumIn() != 1 || typ.NumOut() != 0 || typ.IsVariadic() {
+		return 0
+	}
+	yield := typ.In(0)
+	if yield.Kind() != reflect.Func || yield.IsVariadic() {
+		return 0
+	}
+	if yield.NumOut() != 1 || yield.Out(0).Kind() != reflect.Bool {
+		return 0
+	}
+	if n := yield.NumIn(); n == 1 || n == 2 {
+		return n
+	}
+	return 0
+}
+
+// IsSeq returns true if "v" is a function with [iter.Seq] or [iter.Seq2]
+// signature.
+func IsSeq(v any) bool { return SeqArity(reflect.TypeOf(v)) > 0 }
+
+// Collect collects at most "limit" items yielded by a function with
+// [iter.Seq] or [iter.Seq2] signature. For [iter.Seq2] the first yielded
+// values are returned in "keys". The "more" is set to true when the iterator
+// tried to yield more than "limit" items. Nil iterator yields no items.
+func Collect(
+	seq reflect.Value,
+	limit int,
+) (keys, vals []reflect.Value, more bool) {
+
+	if seq.IsNil() {
+		return nil, nil, false
+	}
+	arity := SeqArity(seq.Type())
+	fn := func(args []reflect.Value) []reflect.Value {
+		if len(vals) == limit {
+			more = true
+			return []reflect.Value{reflect.ValueOf(false)}
+		}
+		if arity == 2 {
+			keys = append(keys, args[0])
+		}
+		vals = append(vals, args[arity-1])
+		return []reflect.Value{reflect.ValueOf(true)}
+	}
+	yield := reflect.MakeFunc(seq.Type().In(0), fn)
+	seq.Call([]reflect.Value{yield})
+	return keys, vals, more
+}
+
+// Replay returns a function of the "typ" iterator type yielding "keys" and
+// "vals" collected with [Collect]. It is used to render or check collected
+// items without calling the original iterator again. When "more" is true,
+// zero values are yielded after the collected items, so [Collect] called on
+// the returned iterator with the same limit reports more items too.
+func Replay(
+	typ reflect.Type,
+	keys, vals []reflect.Value,
+	more bool,
+) reflect.Value {
+
+	arity := SeqArity(typ)
+	yieldTyp := typ.In(0)
+	fn := func(args []reflect.Value) []reflect.Value {
+		yield := args[0]
+		in := make([]reflect.Value, arity)
+		for i := 0; i < len(vals) || (more && i == len(vals)); i++ {
+			for j := range arity {
+				in[j] = reflect.Zero(yieldTyp.In(j))
+			}
+			if i < len(vals) {
+				if arity == 2 {
+					in[0] = keys[i]
+				}
+				in[arity-1] = vals[i]
+			}
+			if !yield.Call(in)[0].Bool() {
+				break
+			}
+		}
+		return nil
+	}
+	return reflect.MakeFunc(typ, fn)
+}
@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package core
+
+import (
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func Test_SeqArity_tabular(t *testing.T) {
+	var nilSeq iter.Seq[int]
+
+	tt := []struct {
+		testN string
+
+		val  any
+		want int
+	}{
+		{"iter.Seq", slices.Values([]int{1}), 1},
+		{"iter.Seq2", maps.All(map[int]int{}), 2},
+		{"nil iter.Seq", nilSeq, 1},
+		{"func literal", func(yield func(string) bool) {}, 1},
+		{"nil", nil, 0},
+		{"int", 42, 0},
+		{"func without arguments", func() {}, 0},
+		{"with result", func(yield func(int) bool) bool { return true }, 0},
+		{"yield not func", func(int) {}, 0},
+		{"yield without return", func(yield func(int)) {}, 0},
+		{"yield returning int", func(yield func(int) int) {}, 0},
+		{"yield with three arguments", func(yield func(int, int, int) bool) {}, 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := SeqArity(reflect.TypeOf(tc.val))
+
+			// --- Then ---
+			if tc.want != have {
+				format := "expected arity:\n  want: %d\n  have: %d"
+				t.Errorf(format, tc.want, have)
+			}
+		})
+	}
+}
+
+func Test_IsSeq(t *testing.T) {
+	t.Run("seq", func(t *testing.T) {
+		// --- When ---
+		have := IsSeq(slices.Values([]int{1}))
+
+		// --- Then ---
+		if !have {
+			t.Error("expected iterator")
+		}
+	})
+
+	t.Run("not seq", func(t *testing.T) {
+		// --- When ---
+		have := IsSeq([]int{1})
+
+		// --- Then ---
+		if have {
+			t.Error("expected not iterator")
+		}
+	})
+}
+
+func Test_Collect(t *testing.T) {
+	t.Run("iter.Seq", func(t *testing.T) {
+		// --- Given ---
+		seq := slices.Values([]int{1, 2, 3})
+
+		// --- When ---
+		keys, vals, more := Collect(reflect.ValueOf(seq), 10)
+
+		// --- Then ---
+		if keys != nil {
+			t.Errorf("expected nil keys:\n  have: %v", keys)
+		}
+		if len(vals) != 3 || vals[0].Int() != 1 || vals[2].Int() != 3 {
+			t.Errorf("unexpected values:\n  have: %v", vals)
+		}
+		if more {
+			t.Error("expected more to be false")
+		}
+	})
+
+	t.Run("iter.Seq2", func(t *testing.T) {
+		// --- Given ---
+		seq := slices.All([]string{"a", "b"})
+
+		// --- When ---
+		keys, vals, more := Collect(reflect.ValueOf(seq), 10)
+
+		// --- Then ---
+		if len(keys) != 2 || keys[0].Int() != 0 || keys[1].Int() != 1 {
+			t.Errorf("unexpected keys:\n  have: %v", keys)
+		}
+		if len(vals) != 2 || vals[0].String() != "a" || vals[1].String() != "b" {
+			t.Errorf("unexpected values:\n  have: %v", vals)
+		}
+		if more {
+			t.Error("expected more to be false")
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		// --- Given ---
+		seq := func(yield func(int) bool) {
+			for i := 0; ; i++ {
+				if !yield(i) {
+					return
+				}
+			}
+		}
+
+		// --- When ---
+		keys, vals, more := Collect(reflect.ValueOf(seq), 2)
+
+		// --- Then ---
+		if keys != nil {
+			t.Errorf("expected nil keys:\n  have: %v", keys)
+		}
+		if len(vals) != 2 {
+			t.Errorf("expected 2 values:\n  have: %d", len(vals))
+		}
+		if !more {
+			t.Error("expected more to be true")
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		// --- Given ---
+		var seq iter.Seq[int]
+
+		// --- When ---
+		keys, vals, more := Collect(reflect.ValueOf(seq), 2)
+
+		// --- Then ---
+		if keys != nil || vals != nil || more {
+			t.Errorf("expected no items:\n  have: %v %v %v", keys, vals, more)
+		}
+	})
+}
+
+func Test_Replay(t *testing.T) {
+	t.Run("iter.Seq", func(t *testing.T) {
+		// --- Given ---
+		calls := 0
+		seq := func(yield func(int) bool) {
+			calls++
+			for _, v := range []int{1, 2} {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+		val := reflect.ValueOf(seq)
+		keys, vals, more := Collect(val, 10)
+
+		// --- When ---
+		have := Replay(val.Type(), keys, vals, more)
+
+		// --- Then ---
+		got := slices.Collect(have.Interface().(func(func(int) bool)))
+		if !reflect.DeepEqual([]int{1, 2}, got) {
+			t.Errorf("expected replayed values:\n  have: %v", got)
+		}
+		if calls != 1 {
+			t.Errorf("expected iterator to be called once:\n  have: %d", calls)
+		}
+	})
+
+	t.Run("iter.Seq2", func(t *testing.T) {
+		// --- Given ---
+		seq := slices.All([]string{"a", "b"})
+		val := reflect.ValueOf(seq)
+		keys, vals, more := Collect(val, 10)
+
+		// --- When ---
+		have := Replay(val.Type(), keys, vals, more)
+
+		// --- Then ---
+		hKeys, hVals, hMore := Collect(have, 10)
+		if len(hKeys) != 2 || hKeys[1].Int() != 1 || hVals[1].String() != "b" {
+			t.Errorf("expected replayed keys and values")
+		}
+		if hMore {
+			t.Error("expected no more items")
+		}
+	})
+
+	t.Run("more", func(t *testing.T) {
+		// --- Given ---
+		seq := slices.Values([]int{1, 2, 3})
+		val := reflect.ValueOf(seq)
+		keys, vals, more := Collect(val, 2)
+
+		// --- When ---
+		have := Replay(val.Type(), keys, vals, more)
+
+		// --- Then ---
+		_, hVals, hMore := Collect(have, 2)
+		if len(hVals) != 2 || !hMore {
+			t.Errorf("expected two items and more:\n  have: %d %v", len(hVals), hMore)
+		}
+	})
+
+	t.Run("stops when yield returns false", func(t *testing.T) {
+		// --- Given ---
+		seq := slices.Values([]int{1, 2, 3})
+		val := reflect.ValueOf(seq)
+		keys, vals, more := Collect(val, 10)
+		have := Replay(val.Type(), keys, vals, more)
+
+		// --- When ---
+		var got []int
+		for v := range have.Interface().(iter.Seq[int]) {
+			got = append(got, v)
+			break
+		}
+
+		// --- Then ---
+		if !reflect.DeepEqual([]int{1}, got) {
+			t.Errorf("expected one value:\n  have: %v", got)
+		}
+	})
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+// Package inline rewrites string literal arguments of function calls in Go
+// source files.
+package inline
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// std tracks files rewritten by [Rewrite].
+var std = newRewriter()
+
+// Rewrite replaces the string literal passed as argument with index "arg" to
+// the function "fn" from the package with "pkg" import path called at the
+// "line" of the Go source file "pth" with the literal representing "value".
+// Calls to functions with the same name from other packages are not matched.
+// The "line" is the line of the call as compiled, lines added or removed by
+// previous rewrites of the same file are taken into account. The file is
+// formatted with [format.Source].
+func Rewrite(
+	pth string,
+	line int,
+	pkg string,
+	fn string,
+	arg int,
+	value string,
+) error {
+
+	return std.rewrite(pth, line, pkg, fn, arg, value)
+}
+
+// Literal returns Go string literal representing "value". Values with new
+// lines, double quotes or backslashes are represented as raw string literals
+// when possible.
+func Literal(value string) string {
+	if strings.ContainsAny(value, "\n\"\\") && canBackquote(value) {
+		return "`" + value + "`"
+	}
+	return strconv.Quote(value)
+}
+
+// canBackquote returns true if "value" can be represented as a raw string
+// literal without changing it.
+func canBackquote(value string) bool {
+	return strconv.CanBackquote(strings.ReplaceAll(value, "\n", ""))
+}
+
+// shift represents number of lines added to the file by a rewrite.
+type shift struct {
+	line  int // Original line of the rewritten call.
+	delta int // Number of lines added (negative when removed).
+}
+
+// rewriter rewrites Go source files and tracks line shifts caused by it.
+type rewriter struct {
+	mx     sync.Mutex
+	shifts map[string][]shift // Line shifts by file path.
+}
+
+// newRewriter returns new instance of rewriter.
+func newRewriter() *rewriter {
+	return &rewriter{shifts: make(map[string][]shift)}
+}
+
+// rewrite implements [Rewrite].
+func (rw *rewriter) rewrite(
+	pth string,
+	line int,
+	pkg string,
+	fn string,
+	arg int,
+	value string,
+) error {
+
+	rw.mx.Lock()
+	defer rw.mx.Unlock()
+
+	inf, err := os.Stat(pth)
+	if err != nil {
+		return err
+	}
+	src, err := os.ReadFile(pth)
+	if err != nil {
+		return err
+	}
+
+	cur := line
+	for _, sh := range rw.shifts[pth] {
+		if sh.line < line {
+			cur += sh.delta
+		}
+	}
+
+	fset := token.NewFileSet()
+	fil, err := parser.ParseFile(fset, pth, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	lit, err := findLiteral(fset, fil, cur, pkg, fn, arg)
+	if err != nil {
+		return err
+	}
+	start := fset.Position(lit.Pos()).Offset
+	end := fset.Position(lit.End()).Offset
+	val := Literal(value)
+
+	buf := &bytes.Buffer{}
+	buf.Write(src[:start])
+	buf.WriteString(val)
+	buf.Write(src[end:])
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(pth, out, inf.Mode().Perm()); err != nil {
+		return err
+	}
+
+	delta := strings.Count(val, "\n") - strings.Count(lit.Value, "\n")
+	if delta != 0 {
+		rw.shifts[pth] = append(rw.shifts[pth], shift{line: line, delta: delta})
+	}
+	return nil
+}
+
+// findLiteral finds the string literal passed as argument with index "arg" to
+// the function "fn" from the package with "pkg" import path called at the
+// "line".
+func findLiteral(
+	fset *token.FileSet,
+	fil *ast.File,
+	line int,
+	pkg string,
+	fn string,
+	arg int,
+) (*ast.BasicLit, error) {
+
+	name := pkg + "." + fn
+	local := importName(fil, pkg)
+	var calls []*ast.CallExpr
+	ast.Inspect(fil, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if sel, fun := funcName(call.Fun); fun != fn || sel != local {
+			return true
+		}
+		start := fset.Position(call.Pos()).Line
+		end := fset.Position(call.Lparen).Line
+		if start <= line && line <= end {
+			calls = append(calls, call)
+		}
+		return true
+	})
+
+	switch {
+	case len(calls) == 0:
+		return nil, fmt.Errorf("no call to %s at line %d", name, line)
+	case len(calls) > 1:
+		return nil, fmt.Errorf("multiple calls to %s at line %d", name, line)
+	}
+	call := calls[0]
+	if arg >= len(call.Args) {
+		return nil, fmt.Errorf("no argument %d in call to %s", arg, name)
+	}
+	lit, ok := call.Args[arg].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil, errors.New("expected string literal argument")
+	}
+	return lit, nil
+}
+
+// importName returns the name functions from the package with "pkg" import
+// path are qualified with in the file. Returns an empty string when the
+// functions are not qualified - the package is dot-imported or the file is
+// part of the package. Returns "_" when the package is not accessible.
+func importName(fil *ast.File, pkg string) string {
+	for _, spec := range fil.Imports {
+		if pth, _ := strconv.Unquote(spec.Path.Value); pth != pkg {
+			continue
+		}
+		switch {
+		case spec.Name == nil:
+			return path.Base(pkg)
+		case spec.Name.Name == ".":
+			return ""
+		}
+		return spec.Name.Name
+	}
+	if fil.Name.Name == path.Base(pkg) {
+		return ""
+	}
+	return "_"
+}
+
+// funcName returns the name of the called function or method and the name of
+// the identifier it is selected from (empty when not selected).
+func funcName(expr ast.Expr) (string, string) {
+	switch fun := expr.(type) {
+	case *ast.Ident:
+		return "", fun.Name
+	case *ast.SelectorExpr:
+		if x, ok := fun.X.(*ast.Ident); ok {
+			return x.Name, fun.Sel.Name
+		}
+		return "", ""
+	case *ast.IndexExpr:
+		return funcName(fun.X)
+	case *ast.IndexListExpr:
+		return funcName(fun.X)
+	}
+	return "", ""
+}
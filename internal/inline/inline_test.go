@@ -0,0 +1,390 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package inline
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_Literal(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		value string
+		want  string
+	}{
+		{"empty", "", `""`},
+		{"simple", "abc", `"abc"`},
+		{"double quotes", `"abc"`, "`\"abc\"`"},
+		{"backslash", `a\b`, "`a\\b`"},
+		{"multi line", "a\nb", "`a\nb`"},
+		{"multi line with backquote", "a\n`b`", `"a\n` + "`b`" + `"`},
+		{"multi line with carriage return", "a\r\nb", `"a\r\nb"`},
+		{"tab", "a\tb", `"a\tb"`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := Literal(tc.value)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_rewriter_rewrite(t *testing.T) {
+	t.Run("rewrite", func(t *testing.T) {
+		// --- Given ---
+		pth := writeSource(t, source)
+		rw := newRewriter()
+
+		// --- When ---
+		err := rw.rewrite(pth, 8, pkg, "Snap", 1, "a\nb")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := "package example\n" +
+			"\n" +
+			"import \"example.com/pkg\"\n" +
+			"\n" +
+			"func Example() {\n" +
+			"\t// Comment.\n" +
+			"\tpkg.Snap(1, \"\")\n" +
+			"\tpkg.Snap(2, `a\n" +
+			"b`)\n" +
+			"\tpkg.Snap(\n" +
+			"\t\t3,\n" +
+			"\t\t\"\",\n" +
+			"\t)\n" +
+			"}\n"
+		affirm.Equal(t, want, readSource(t, pth))
+	})
+
+	t.Run("lines added by previous rewrites", func(t *testing.T) {
+		// --- Given ---
+		pth := writeSource(t, source)
+		rw := newRewriter()
+
+		// --- When ---
+		affirm.Nil(t, rw.rewrite(pth, 7, pkg, "Snap", 1, "a\nb\nc"))
+		affirm.Nil(t, rw.rewrite(pth, 8, pkg, "Snap", 1, "d"))
+		affirm.Nil(t, rw.rewrite(pth, 9, pkg, "Snap", 1, "e"))
+
+		// --- Then ---
+		want := "package example\n" +
+			"\n" +
+			"import \"example.com/pkg\"\n" +
+			"\n" +
+			"func Example() {\n" +
+			"\t// Comment.\n" +
+			"\tpkg.Snap(1, `a\n" +
+			"b\n" +
+			"c`)\n" +
+			"\tpkg.Snap(2, \"d\")\n" +
+			"\tpkg.Snap(\n" +
+			"\t\t3,\n" +
+			"\t\t\"e\",\n" +
+			"\t)\n" +
+			"}\n"
+		affirm.Equal(t, want, readSource(t, pth))
+	})
+
+	t.Run("lines removed by previous rewrites", func(t *testing.T) {
+		// --- Given ---
+		pth := writeSource(t, source)
+		rw := newRewriter()
+		affirm.Nil(t, rw.rewrite(pth, 7, pkg, "Snap", 1, "a\nb"))
+		affirm.Nil(t, rw.rewrite(pth, 7, pkg, "Snap", 1, "c"))
+
+		// --- When ---
+		err := rw.rewrite(pth, 9, pkg, "Snap", 1, "d")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := "package example\n" +
+			"\n" +
+			"import \"example.com/pkg\"\n" +
+			"\n" +
+			"func Example() {\n" +
+			"\t// Comment.\n" +
+			"\tpkg.Snap(1, \"c\")\n" +
+			"\tpkg.Snap(2, \"\")\n" +
+			"\tpkg.Snap(\n" +
+			"\t\t3,\n" +
+			"\t\t\"d\",\n" +
+			"\t)\n" +
+			"}\n"
+		affirm.Equal(t, want, readSource(t, pth))
+	})
+
+	t.Run("same name from other package is not matched", func(t *testing.T) {
+		// --- Given ---
+		src := "package example\n" +
+			"\n" +
+			"import (\n" +
+			"\t\"example.com/other\"\n" +
+			"\t\"example.com/pkg\"\n" +
+			")\n" +
+			"\n" +
+			"var _, _ = pkg.Snap(1, \"\"), other.Snap(\"\", 2)\n"
+		pth := writeSource(t, src)
+		rw := newRewriter()
+
+		// --- When ---
+		err := rw.rewrite(pth, 8, pkg, "Snap", 1, "a")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := "package example\n" +
+			"\n" +
+			"import (\n" +
+			"\t\"example.com/other\"\n" +
+			"\t\"example.com/pkg\"\n" +
+			")\n" +
+			"\n" +
+			"var _, _ = pkg.Snap(1, \"a\"), other.Snap(\"\", 2)\n"
+		affirm.Equal(t, want, readSource(t, pth))
+	})
+
+	t.Run("error file does not exist", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "example.go")
+		rw := newRewriter()
+
+		// --- When ---
+		err := rw.rewrite(pth, 7, pkg, "Snap", 1, "a")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("error invalid source", func(t *testing.T) {
+		// --- Given ---
+		pth := writeSource(t, "package")
+		rw := newRewriter()
+
+		// --- When ---
+		err := rw.rewrite(pth, 1, pkg, "Snap", 1, "a")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, pth+":1:8: expected 'IDENT', found 'EOF'", err.Error())
+	})
+
+	t.Run("error no call at line", func(t *testing.T) {
+		// --- Given ---
+		pth := writeSource(t, source)
+		rw := newRewriter()
+
+		// --- When ---
+		err := rw.rewrite(pth, 6, pkg, "Snap", 1, "a")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "no call to example.com/pkg.Snap at line 6"
+		affirm.Equal(t, wMsg, err.Error())
+		affirm.Equal(t, source, readSource(t, pth))
+	})
+
+	t.Run("error only call from other package at line", func(t *testing.T) {
+		// --- Given ---
+		src := "package example\n" +
+			"\n" +
+			"import \"example.com/other\"\n" +
+			"\n" +
+			"var _ = other.Snap(1, \"\")\n"
+		pth := writeSource(t, src)
+		rw := newRewriter()
+
+		// --- When ---
+		err := rw.rewrite(pth, 5, pkg, "Snap", 1, "a")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "no call to example.com/pkg.Snap at line 5"
+		affirm.Equal(t, wMsg, err.Error())
+		affirm.Equal(t, src, readSource(t, pth))
+	})
+
+	t.Run("error multiple calls at line", func(t *testing.T) {
+		// --- Given ---
+		src := "package example\n" +
+			"\n" +
+			"import \"example.com/pkg\"\n" +
+			"\n" +
+			"var _ = pkg.Snap(1, pkg.Snap(2, \"\"))\n"
+		pth := writeSource(t, src)
+		rw := newRewriter()
+
+		// --- When ---
+		err := rw.rewrite(pth, 5, pkg, "Snap", 1, "a")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "multiple calls to example.com/pkg.Snap at line 5"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error no argument", func(t *testing.T) {
+		// --- Given ---
+		pth := writeSource(t, source)
+		rw := newRewriter()
+
+		// --- When ---
+		err := rw.rewrite(pth, 7, pkg, "Snap", 2, "a")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "no argument 2 in call to example.com/pkg.Snap"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error not string literal", func(t *testing.T) {
+		// --- Given ---
+		pth := writeSource(t, source)
+		rw := newRewriter()
+
+		// --- When ---
+		err := rw.rewrite(pth, 7, pkg, "Snap", 0, "a")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, "expected string literal argument", err.Error())
+	})
+}
+
+func Test_importName(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		src  string
+		want string
+	}{
+		{
+			"default name",
+			"package example\n\nimport \"example.com/pkg\"\n",
+			"pkg",
+		},
+		{
+			"alias",
+			"package example\n\nimport alias \"example.com/pkg\"\n",
+			"alias",
+		},
+		{
+			"dot import",
+			"package example\n\nimport . \"example.com/pkg\"\n",
+			"",
+		},
+		{
+			"blank import",
+			"package example\n\nimport _ \"example.com/pkg\"\n",
+			"_",
+		},
+		{
+			"the same package",
+			"package pkg\n",
+			"",
+		},
+		{
+			"not imported",
+			"package example\n\nimport \"example.com/other\"\n",
+			"_",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			fset := token.NewFileSet()
+			fil, err := parser.ParseFile(fset, "example.go", tc.src, 0)
+			affirm.Nil(t, err)
+
+			// --- When ---
+			have := importName(fil, pkg)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_funcName(t *testing.T) {
+	t.Run("generic function", func(t *testing.T) {
+		// --- Given ---
+		src := "package pkg\n\nvar _ = Snap[int](1, \"\")\n"
+		pth := writeSource(t, src)
+		rw := newRewriter()
+
+		// --- When ---
+		err := rw.rewrite(pth, 3, pkg, "Snap", 1, "a")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := "package pkg\n\nvar _ = Snap[int](1, \"a\")\n"
+		affirm.Equal(t, want, readSource(t, pth))
+	})
+
+	t.Run("generic function with multiple type parameters", func(t *testing.T) {
+		// --- Given ---
+		src := "package example\n\n" +
+			"import \"example.com/pkg\"\n\n" +
+			"var _ = pkg.Snap[int, string](1, \"\")\n"
+		pth := writeSource(t, src)
+		rw := newRewriter()
+
+		// --- When ---
+		err := rw.rewrite(pth, 5, pkg, "Snap", 1, "a")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := "package example\n\n" +
+			"import \"example.com/pkg\"\n\n" +
+			"var _ = pkg.Snap[int, string](1, \"a\")\n"
+		affirm.Equal(t, want, readSource(t, pth))
+	})
+}
+
+// pkg is the import path of the package with the function used in tests.
+const pkg = "example.com/pkg"
+
+// source is the Go source file used in tests.
+const source = "package example\n" +
+	"\n" +
+	"import \"example.com/pkg\"\n" +
+	"\n" +
+	"func Example() {\n" +
+	"\t// Comment.\n" +
+	"\tpkg.Snap(1, \"\")\n" +
+	"\tpkg.Snap(2, \"\")\n" +
+	"\tpkg.Snap(\n" +
+	"\t\t3,\n" +
+	"\t\t\"\",\n" +
+	"\t)\n" +
+	"}\n"
+
+// writeSource writes Go source file to a temporary directory and returns its
+// path.
+func writeSource(t *testing.T, src string) string {
+	t.Helper()
+	pth := filepath.Join(t.TempDir(), "example.go")
+	affirm.Nil(t, os.WriteFile(pth, []byte(src), 0o600))
+	return pth
+}
+
+// readSource returns contents of the Go source file at "pth".
+func readSource(t *testing.T, pth string) string {
+	t.Helper()
+	data, err := os.ReadFile(pth)
+	affirm.Nil(t, err)
+	return string(data)
+}
@@ -7,6 +7,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -41,6 +42,12 @@ type TStrType string
 // TItf is an interface used in tests.
 type TItf interface{ AAA() string }
 
+// TItfUnx is an interface with unexported method used in tests.
+type TItfUnx interface {
+	AAA() string
+	bbb() int
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 
 type TInt struct{ V int }
@@ -142,6 +149,68 @@ func (typ *TPtr) PS(a, b string) string { return a + typ.Val + b }
 
 // /////////////////////////////////////////////////////////////////////////////
 
+// TMis has method with the same name as [TItf] but different signature.
+type TMis struct{ Val int }
+
+func (typ TMis) AAA() int { return typ.Val }
+
+// /////////////////////////////////////////////////////////////////////////////
+
+// TSeq has an iterator field.
+type TSeq struct{ Seq iter.Seq[int] }
+
+// /////////////////////////////////////////////////////////////////////////////
+
+// TPtrInt has a pointer to int field.
+type TPtrInt struct{ Ptr *int }
+
+// /////////////////////////////////////////////////////////////////////////////
+
+// TTxt implements text marshaling which encodes only the Val field.
+type TTxt struct {
+	Val string
+	Num int
+}
+
+func (typ TTxt) MarshalText() ([]byte, error) { return []byte(typ.Val), nil }
+
+func (typ *TTxt) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("empty text")
+	}
+	typ.Val = string(data)
+	return nil
+}
+
+// /////////////////////////////////////////////////////////////////////////////
+
+// TTxtEnc implements only text marshaling.
+type TTxtEnc string
+
+func (typ TTxtEnc) MarshalText() ([]byte, error) { return []byte(typ), nil }
+
+// /////////////////////////////////////////////////////////////////////////////
+
+// TBin implements binary marshaling which encodes only the Val field.
+type TBin struct {
+	Val uint16
+	Tag string
+}
+
+func (typ TBin) MarshalBinary() ([]byte, error) {
+	return []byte{byte(typ.Val >> 8), byte(typ.Val)}, nil
+}
+
+func (typ *TBin) UnmarshalBinary(data []byte) error {
+	if len(data) != 2 {
+		return errors.New("invalid length")
+	}
+	typ.Val = uint16(data[0])<<8 | uint16(data[1])
+	return nil
+}
+
+// /////////////////////////////////////////////////////////////////////////////
+
 type T1 struct {
 	Int int
 	T1  *T1 // Recursive.
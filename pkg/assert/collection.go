@@ -5,7 +5,9 @@ package assert
 
 import (
 	"cmp"
+	"reflect"
 
+	"github.com/ctx42/testing/internal/constraints"
 	"github.com/ctx42/testing/internal/core"
 	"github.com/ctx42/testing/pkg/check"
 	"github.com/ctx42/testing/pkg/tester"
@@ -15,6 +17,14 @@ import (
 // marks the test as failed, writes error message to test log and returns false.
 func Len(t tester.T, want int, have any, opts ...check.Option) bool {
 	t.Helper()
+	if core.IsSeq(have) {
+		// Collect items once, so the iterator is not called again below.
+		val := reflect.ValueOf(have)
+		if !val.IsNil() {
+			keys, vals, more := core.Collect(val, core.SeqLimit)
+			have = core.Replay(val.Type(), keys, vals, more).Interface()
+		}
+	}
 	if e := check.Len(want, have, opts...); e != nil {
 		cnt, _ := core.Len(have)
 		if want > cnt {
@@ -140,3 +150,258 @@ func MapsSubset[K cmp.Ordered, V any](
 	}
 	return true
 }
+
+// ElementsMatch asserts "want" and "have" slices have the same elements
+// regardless of their order. Returns true if they do, otherwise marks the test
+// as failed, writes error message to test log and returns false.
+func ElementsMatch[T any](
+	t tester.T,
+	want, have []T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.ElementsMatch(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Unique asserts "have" slice has no duplicate values. Returns true if it
+// doesn't, otherwise marks the test as failed, writes error message to test
+// log and returns false.
+func Unique[T comparable](t tester.T, have []T, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.Unique(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// NoDuplicates asserts "have" slice has no duplicate values using
+// [check.Equal] rules. Returns true if it doesn't, otherwise marks the test as
+// failed, writes error message to test log and returns false.
+func NoDuplicates[T any](t tester.T, have []T, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.NoDuplicates(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Sorted asserts "have" slice is sorted in ascending order. Returns true if it
+// is, otherwise marks the test as failed, writes error message to test log and
+// returns false.
+func Sorted[T constraints.Ordered](
+	t tester.T,
+	have []T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.Sorted(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// SortedFunc asserts "have" slice is sorted in ascending order as defined by
+// the "compare" function. Returns true if it is, otherwise marks the test as
+// failed, writes error message to test log and returns false.
+func SortedFunc[T any](
+	t tester.T,
+	have []T,
+	compare func(a, b T) int,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.SortedFunc(have, compare, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// StrictlyIncreasing asserts every element of "have" slice is greater than the
+// previous one. Returns true if it is, otherwise marks the test as failed,
+// writes error message to test log and returns false.
+func StrictlyIncreasing[T constraints.Ordered](
+	t tester.T,
+	have []T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.StrictlyIncreasing(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Decreasing asserts every element of "have" slice is less or equal to the
+// previous one. Returns true if it is, otherwise marks the test as failed,
+// writes error message to test log and returns false.
+func Decreasing[T constraints.Ordered](
+	t tester.T,
+	have []T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.Decreasing(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Each asserts "chk" passes for every element of a slice or an array, or every
+// value of a map. Returns true if it does, otherwise marks the test as failed,
+// writes error message to test log and returns false.
+func Each[T any](
+	t tester.T,
+	have any,
+	chk func(have T, opts ...check.Option) error,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.Each(have, chk, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// AnyMatch asserts at least one element of a slice, an array or a map value
+// matches the predicate. Returns true if it does, otherwise marks the test as
+// failed, writes error message to test log and returns false.
+func AnyMatch[T any](
+	t tester.T,
+	have any,
+	pred func(have T) bool,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.AnyMatch(have, pred, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// NoneMatch asserts no element of a slice, an array or a map value matches
+// the predicate. Returns true if none does, otherwise marks the test as
+// failed, writes error message to test log and returns false.
+func NoneMatch[T any](
+	t tester.T,
+	have any,
+	pred func(have T) bool,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.NoneMatch(have, pred, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// CountFunc asserts "want" number of elements of a slice, an array or a map
+// value match the predicate. Returns true if they do, otherwise marks the test
+// as failed, writes error message to test log and returns false.
+func CountFunc[T any](
+	t tester.T,
+	want int,
+	have any,
+	pred func(have T) bool,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.CountFunc(want, have, pred, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// MapKeys asserts "have" map has exactly the "want" keys regardless of their
+// order. Returns true if it does, otherwise marks the test as failed, writes
+// error message to test log and returns false.
+func MapKeys[K comparable, V any](
+	t tester.T,
+	want []K,
+	have map[K]V,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.MapKeys(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// MapHasKeys asserts "have" map has all the "want" keys. Returns true if it
+// does, otherwise marks the test as failed, writes error message to test log
+// and returns false.
+func MapHasKeys[K comparable, V any](
+	t tester.T,
+	want []K,
+	have map[K]V,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.MapHasKeys(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// MapValuesMatch asserts "have" map values are the same as "want" values
+// regardless of their order. Returns true if they are, otherwise marks the
+// test as failed, writes error message to test log and returns false.
+func MapValuesMatch[K comparable, V any](
+	t tester.T,
+	want []V,
+	have map[K]V,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.MapValuesMatch(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// MapEach asserts "fn" returns nil for each key and value of "have" map.
+// Returns true if it does, otherwise marks the test as failed, writes error
+// message to test log and returns false.
+func MapEach[K comparable, V any](
+	t tester.T,
+	have map[K]V,
+	fn func(k K, v V) error,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.MapEach(have, fn, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
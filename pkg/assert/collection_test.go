@@ -68,6 +68,29 @@ func Test_Len(t *testing.T) {
 		// --- Then ---
 		affirm.False(t, have)
 	})
+
+	t.Run("error for one shot iterator", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  want: 1\n  have: 2")
+		tspy.Close()
+
+		var used bool
+		seq := func(yield func(int) bool) {
+			if used {
+				return
+			}
+			used = true
+			_ = yield(1) && yield(2)
+		}
+
+		// --- When ---
+		have := Len(tspy, 1, seq)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
 }
 
 func Test_Has(t *testing.T) {
@@ -496,3 +519,672 @@ func Test_MapsSubset(t *testing.T) {
 		affirm.False(t, have)
 	})
 }
+
+func Test_ElementsMatch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := ElementsMatch(tspy, []int{1, 2}, []int{2, 1})
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := ElementsMatch(tspy, []int{1, 2}, []int{1, 1})
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("       trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := ElementsMatch(tspy, []int{1, 2}, []int{1, 1}, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_Unique(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Unique(tspy, []int{1, 2})
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := Unique(tspy, []int{1, 1})
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := Unique(tspy, []int{1, 1}, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_NoDuplicates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := NoDuplicates(tspy, []any{1, 2})
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := NoDuplicates(tspy, []any{1, 1})
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := NoDuplicates(tspy, []any{1, 1}, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_Sorted(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Sorted(tspy, []int{1, 2})
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := Sorted(tspy, []int{2, 1})
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := Sorted(tspy, []int{2, 1}, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_SortedFunc(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		compare := func(a, b int) int { return a - b }
+
+		// --- When ---
+		have := SortedFunc(tspy, []int{1, 2}, compare)
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		compare := func(a, b int) int { return a - b }
+
+		// --- When ---
+		have := SortedFunc(tspy, []int{2, 1}, compare)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		compare := func(a, b int) int { return a - b }
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := SortedFunc(tspy, []int{2, 1}, compare, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_StrictlyIncreasing(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := StrictlyIncreasing(tspy, []int{1, 2})
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := StrictlyIncreasing(tspy, []int{1, 1})
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := StrictlyIncreasing(tspy, []int{1, 1}, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_Decreasing(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Decreasing(tspy, []int{2, 1})
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := Decreasing(tspy, []int{1, 2})
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := Decreasing(tspy, []int{1, 2}, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_Each(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		chk := check.NotZero
+
+		// --- When ---
+		have := Each(tspy, []int{1, 2}, chk)
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		chk := check.NotZero
+
+		// --- When ---
+		have := Each(tspy, []int{1, 0}, chk)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field[1]\n")
+		tspy.Close()
+
+		chk := check.NotZero
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := Each(tspy, []int{1, 0}, chk, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_AnyMatch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pred := func(have int) bool { return have > 1 }
+
+		// --- When ---
+		have := AnyMatch(tspy, []int{1, 2}, pred)
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		pred := func(have int) bool { return have > 1 }
+
+		// --- When ---
+		have := AnyMatch(tspy, []int{0, 1}, pred)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		pred := func(have int) bool { return have > 1 }
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := AnyMatch(tspy, []int{0, 1}, pred, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_NoneMatch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pred := func(have int) bool { return have > 1 }
+
+		// --- When ---
+		have := NoneMatch(tspy, []int{0, 1}, pred)
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		pred := func(have int) bool { return have > 1 }
+
+		// --- When ---
+		have := NoneMatch(tspy, []int{1, 2}, pred)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field[1]\n")
+		tspy.Close()
+
+		pred := func(have int) bool { return have > 1 }
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := NoneMatch(tspy, []int{1, 2}, pred, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_CountFunc(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pred := func(have int) bool { return have > 1 }
+
+		// --- When ---
+		have := CountFunc(tspy, 1, []int{1, 2}, pred)
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		pred := func(have int) bool { return have > 1 }
+
+		// --- When ---
+		have := CountFunc(tspy, 2, []int{1, 2}, pred)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("     trail: type.field\n")
+		tspy.Close()
+
+		pred := func(have int) bool { return have > 1 }
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := CountFunc(tspy, 2, []int{1, 2}, pred, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_MapKeys(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := MapKeys(tspy, []string{"B", "A"}, map[string]int{"A": 1, "B": 2})
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := MapKeys(tspy, []string{"B"}, map[string]int{"A": 1})
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("              trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := MapKeys(tspy, []string{"B"}, map[string]int{"A": 1}, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_MapHasKeys(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := MapHasKeys(tspy, []string{"A"}, map[string]int{"A": 1, "B": 2})
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := MapHasKeys(tspy, []string{"B"}, map[string]int{"A": 1})
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("           trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := MapHasKeys(tspy, []string{"B"}, map[string]int{"A": 1}, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_MapValuesMatch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := MapValuesMatch(tspy, []int{2, 1}, map[string]int{"A": 1, "B": 2})
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := MapValuesMatch(tspy, []int{2}, map[string]int{"A": 1})
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("       trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := MapValuesMatch(tspy, []int{2}, map[string]int{"A": 1}, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_MapEach(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		fn := func(k string, v int) error { return check.Greater(0, v) }
+
+		// --- When ---
+		have := MapEach(tspy, map[string]int{"A": 1}, fn)
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		fn := func(k string, v int) error { return check.Greater(1, v) }
+
+		// --- When ---
+		have := MapEach(tspy, map[string]int{"A": 1}, fn)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field[\"A\"]\n")
+		tspy.Close()
+
+		fn := func(k string, v int) error { return check.Greater(1, v) }
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := MapEach(tspy, map[string]int{"A": 1}, fn, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
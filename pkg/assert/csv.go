@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// CSV asserts that two CSV documents with a header row are equivalent.
+// Returns true if they are, otherwise marks the test as failed, writes error
+// message to test log and returns false.
+//
+//	assert.CSV(t, "id,price\n1,9.99\n", have, check.WithKeyColumns("id"))
+func CSV(t tester.T, want, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.CSV(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// DirEqual asserts directory tree at "haveDir" is equal to the golden
+// directory tree at "wantDir". Returns true if it is, otherwise marks the test
+// as failed, writes error message to test log and returns false.
+//
+//	assert.DirEqual(t, "testdata/golden", outDir, check.WithIgnoreGlobs("*.log"))
+func DirEqual(
+	t tester.T,
+	wantDir, haveDir string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.DirEqual(wantDir, haveDir, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
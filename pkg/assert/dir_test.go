@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_DirEqual(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		got := DirEqual(tspy, "testdata/dir", "testdata/dir")
+
+		// --- Then ---
+		affirm.True(t, got)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		got := DirEqual(tspy, "testdata/dir", t.TempDir())
+
+		// --- Then ---
+		affirm.False(t, got)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		have := t.TempDir()
+		pth := filepath.Join(have, "file.txt")
+		affirm.Nil(t, os.WriteFile(pth, []byte("content"), 0o600))
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		got := DirEqual(tspy, "testdata/dir", have, opt)
+
+		// --- Then ---
+		affirm.False(t, got)
+	})
+}
@@ -4,6 +4,9 @@
 package assert
 
 import (
+	"io/fs"
+	"time"
+
 	"github.com/ctx42/testing/pkg/check"
 	"github.com/ctx42/testing/pkg/tester"
 )
@@ -73,3 +76,187 @@ func NoDirExist(t tester.T, pth string, opts ...check.Option) bool {
 	}
 	return true
 }
+
+// FileMode asserts "pth" points to a filesystem entry with the "want" mode.
+// Only permission bits are compared unless "want" has any of the type bits
+// set. Returns true on success, otherwise marks the test as failed, writes
+// error message to test log and returns false.
+func FileMode(
+	t tester.T,
+	want fs.FileMode,
+	pth string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.FileMode(want, pth, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// FileSize asserts "pth" points to a file with size of "want" bytes. Returns
+// true on success, otherwise marks the test as failed, writes error message
+// to test log and returns false.
+func FileSize(t tester.T, want int64, pth string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.FileSize(want, pth, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// FileEmpty asserts "pth" points to an empty file. Returns true on success,
+// otherwise marks the test as failed, writes error message to test log and
+// returns false.
+func FileEmpty(t tester.T, pth string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.FileEmpty(pth, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// SymlinkTo asserts "pth" is a symbolic link pointing to "target". Returns
+// true on success, otherwise marks the test as failed, writes error message
+// to test log and returns false.
+func SymlinkTo(t tester.T, target, pth string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.SymlinkTo(target, pth, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// IsExecutable asserts "pth" points to a file with any of the executable
+// permission bits set. Returns true on success, otherwise marks the test as
+// failed, writes error message to test log and returns false.
+func IsExecutable(t tester.T, pth string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.IsExecutable(pth, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// FileModifiedWithin asserts file or directory at "pth" was modified within
+// duration "within" from the current time. Returns true on success, otherwise
+// marks the test as failed, writes error message to test log and returns
+// false.
+func FileModifiedWithin(
+	t tester.T,
+	within time.Duration,
+	pth string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.FileModifiedWithin(within, pth, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// SameFile asserts "want" and "have" paths point to the same file. Returns
+// true on success, otherwise marks the test as failed, writes error message
+// to test log and returns false.
+func SameFile(t tester.T, want, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.SameFile(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// FileEqual asserts content of the file at "pth" is equal to "want". Returns
+// true on success, otherwise marks the test as failed, writes error message
+// to test log and returns false.
+func FileEqual[T check.Content](
+	t tester.T,
+	want T,
+	pth string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.FileEqual(want, pth, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// FilesEqual asserts files at "want" and "have" paths have equal content.
+// Returns true on success, otherwise marks the test as failed, writes error
+// message to test log and returns false.
+func FilesEqual(t tester.T, want, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.FilesEqual(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// FileRegexp asserts content of the file at "pth" matches "want" regular
+// expression. The "want" can be either regular expression string or instance
+// of [regexp.Regexp]. Returns true on success, otherwise marks the test as
+// failed, writes error message to test log and returns false.
+func FileRegexp(t tester.T, want any, pth string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.FileRegexp(want, pth, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// FileJSON asserts content of the file at "pth" is JSON document equivalent
+// to "want". Returns true on success, otherwise marks the test as failed,
+// writes error message to test log and returns false.
+func FileJSON(t tester.T, want, pth string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.FileJSON(want, pth, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// FileLines asserts lines of the file at "pth" are equal to "want". The new
+// line at the end of the file is optional. Returns true on success, otherwise
+// marks the test as failed, writes error message to test log and returns
+// false.
+func FileLines(
+	t tester.T,
+	want []string,
+	pth string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.FileLines(want, pth, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// FileLineCount asserts the file at "pth" has "want" number of lines. Returns
+// true on success, otherwise marks the test as failed, writes error message
+// to test log and returns false.
+func FileLineCount(t tester.T, want int, pth string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.FileLineCount(want, pth, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
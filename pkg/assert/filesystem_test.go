@@ -4,7 +4,11 @@
 package assert
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"testing/fstest"
+	"time"
 
 	"github.com/ctx42/testing/internal/affirm"
 	"github.com/ctx42/testing/pkg/check"
@@ -227,3 +231,582 @@ func Test_NoDirExist(t *testing.T) {
 		affirm.False(t, have)
 	})
 }
+
+func Test_FileMode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := FileMode(tspy, 0o640, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := FileMode(tspy, 0o750, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := FileMode(tspy, 0o750, "file.txt", check.WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_FileSize(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := FileSize(tspy, 3, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := FileSize(tspy, 2, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := FileSize(tspy, 2, "file.txt", check.WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_FileEmpty(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := FileEmpty(tspy, "empty.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := FileEmpty(tspy, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := FileEmpty(tspy, "file.txt", check.WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_SymlinkTo(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		pth := filepath.Join(t.TempDir(), "link")
+		affirm.Nil(t, os.Symlink("file.txt", pth))
+
+		// --- When ---
+		have := SymlinkTo(tspy, "file.txt", pth)
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		pth := filepath.Join(t.TempDir(), "link")
+		affirm.Nil(t, os.Symlink("file.txt", pth))
+
+		// --- When ---
+		have := SymlinkTo(tspy, "other.txt", pth)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		pth := filepath.Join(t.TempDir(), "link")
+		affirm.Nil(t, os.Symlink("file.txt", pth))
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := SymlinkTo(tspy, "other.txt", pth, opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_IsExecutable(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := IsExecutable(tspy, "run.sh", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := IsExecutable(tspy, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := IsExecutable(tspy, "file.txt", check.WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_FileModifiedWithin(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := FileModifiedWithin(tspy, time.Hour, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := FileModifiedWithin(tspy, time.Hour, "empty.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := FileModifiedWithin(tspy, time.Hour, "empty.txt", check.WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_SameFile(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := SameFile(tspy, "testdata/file.txt", "testdata/file.txt")
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := SameFile(tspy, "testdata/file.txt", "testdata/dir")
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := SameFile(tspy, "testdata/file.txt", "testdata/dir", opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_FileEqual(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := FileEqual(tspy, "abc", "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := FileEqual(tspy, "abd", "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := FileEqual(tspy, "abd", "file.txt", check.WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_FilesEqual(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := FilesEqual(tspy, "file.txt", "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := FilesEqual(tspy, "file.txt", "empty.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := FilesEqual(tspy, "file.txt", "empty.txt", check.WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_FileRegexp(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := FileRegexp(tspy, "^ab", "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := FileRegexp(tspy, "^b", "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := FileRegexp(tspy, "^b", "file.txt", check.WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_FileJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := FileJSON(tspy, `{"a": 1}`, "doc.json", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := FileJSON(tspy, `{"a": 2}`, "doc.json", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := FileJSON(tspy, `{"a": 2}`, "doc.json", check.WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_FileLines(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := FileLines(tspy, []string{"abc"}, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := FileLines(tspy, []string{"abd"}, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := FileLines(tspy, []string{"abd"}, "file.txt", check.WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+func Test_FileLineCount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := FileLineCount(tspy, 1, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		have := FileLineCount(tspy, 2, "file.txt", check.WithFS(fsys))
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		have := FileLineCount(tspy, 2, "file.txt", check.WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+}
+
+// fsys is a filesystem used in file assertion tests.
+var fsys = fstest.MapFS{
+	"file.txt": &fstest.MapFile{
+		Data:    []byte("abc"),
+		Mode:    0o640,
+		ModTime: time.Now(),
+	},
+	"empty.txt": &fstest.MapFile{Mode: 0o640},
+	"run.sh":    &fstest.MapFile{Mode: 0o750},
+	"doc.json":  &fstest.MapFile{Data: []byte(`{"a": 1}`)},
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// UUID asserts "have" is a UUID in the canonical "8-4-4-4-12" hexadecimal form.
+// Returns true if it is, otherwise marks the test as failed, writes error
+// message to test log and returns false. See [check.UUID] for details.
+//
+//	assert.UUID(t, "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a")
+func UUID(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.UUID(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// UUIDVersion asserts "have" is a UUID in the canonical form with the "want"
+// version. Returns true if it is, otherwise marks the test as failed, writes
+// error message to test log and returns false. See [check.UUIDVersion] for
+// details.
+//
+//	assert.UUIDVersion(t, 4, "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a")
+func UUIDVersion(t tester.T, want int, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.UUIDVersion(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// ULID asserts "have" is a ULID. Returns true if it is, otherwise marks the
+// test as failed, writes error message to test log and returns false. See
+// [check.ULID] for details.
+//
+//	assert.ULID(t, "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+func ULID(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.ULID(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// RFC3339 asserts "have" is a time in [time.RFC3339] format. Returns true if it
+// is, otherwise marks the test as failed, writes error message to test log and
+// returns false. See [check.RFC3339] for details.
+//
+//	assert.RFC3339(t, "2020-01-02T03:04:05Z")
+func RFC3339(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.RFC3339(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Email asserts "have" is an e-mail address without a display name. Returns
+// true if it is, otherwise marks the test as failed, writes error message to
+// test log and returns false. See [check.Email] for details.
+//
+//	assert.Email(t, "bob@example.com")
+func Email(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.Email(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// URL asserts "have" is an absolute URL with a scheme and a host. Returns true
+// if it is, otherwise marks the test as failed, writes error message to test
+// log and returns false. See [check.URL] for details.
+//
+//	assert.URL(t, "https://example.com")
+func URL(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.URL(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// URLScheme asserts "have" is an absolute URL with a host and one of the "want"
+// schemes. Returns true if it is, otherwise marks the test as failed, writes
+// error message to test log and returns false. See [check.URLScheme] for
+// details.
+//
+//	assert.URLScheme(t, []string{"https"}, "https://example.com")
+func URLScheme(
+	t tester.T,
+	want []string,
+	have string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.URLScheme(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Semver asserts "have" is a semantic version. Returns true if it is, otherwise
+// marks the test as failed, writes error message to test log and returns false.
+// See [check.Semver] for details.
+//
+//	assert.Semver(t, "1.2.3-beta.1")
+func Semver(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.Semver(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Base64 asserts "have" is a padded standard base64 encoded string. Returns
+// true if it is, otherwise marks the test as failed, writes error message to
+// test log and returns false. See [check.Base64] for details.
+//
+//	assert.Base64(t, "YWJj")
+func Base64(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.Base64(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Base64URL asserts "have" is a URL and filename safe base64 encoded string.
+// Returns true if it is, otherwise marks the test as failed, writes error
+// message to test log and returns false. See [check.Base64URL] for details.
+//
+//	assert.Base64URL(t, "YWJj-_8")
+func Base64URL(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.Base64URL(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Hex asserts "have" is a hexadecimal encoded string. Returns true if it is,
+// otherwise marks the test as failed, writes error message to test log and
+// returns false. See [check.Hex] for details.
+//
+//	assert.Hex(t, "00ff")
+func Hex(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.Hex(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// JWT asserts "have" has the structure of a JSON Web Token. Returns true if it
+// is, otherwise marks the test as failed, writes error message to test log and
+// returns false. See [check.JWT] for details.
+//
+//	assert.JWT(t, token)
+func JWT(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.JWT(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// IPv4 asserts "have" is an IPv4 address. Returns true if it is, otherwise
+// marks the test as failed, writes error message to test log and returns false.
+// See [check.IPv4] for details.
+//
+//	assert.IPv4(t, "192.168.0.1")
+func IPv4(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.IPv4(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// IPv6 asserts "have" is an IPv6 address. Returns true if it is, otherwise
+// marks the test as failed, writes error message to test log and returns false.
+// See [check.IPv6] for details.
+//
+//	assert.IPv6(t, "2001:db8::1")
+func IPv6(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.IPv6(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// CIDR asserts "have" is an IP address prefix in CIDR notation. Returns true if
+// it is, otherwise marks the test as failed, writes error message to test log
+// and returns false. See [check.CIDR] for details.
+//
+//	assert.CIDR(t, "10.0.0.0/8")
+func CIDR(t tester.T, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.CIDR(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
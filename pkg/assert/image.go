@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"image"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Image asserts "want" and "have" images are equal. Returns true if they are,
+// otherwise marks the test as failed, writes error message to test log and
+// returns false. See [check.Image] for details.
+//
+//	assert.Image(t, want, have, check.WithImageTolerance(2))
+func Image(t tester.T, want, have image.Image, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.Image(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// FileImage asserts PNG images in the files at "wantPNG" and "havePNG" paths
+// are equal. Returns true if they are, otherwise marks the test as failed,
+// writes error message to test log and returns false. See [check.FileImage]
+// for details.
+//
+//	assert.FileImage(t, "testdata/want.png", "testdata/have.png")
+func FileImage(
+	t tester.T,
+	wantPNG string,
+	havePNG string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.FileImage(wantPNG, havePNG, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
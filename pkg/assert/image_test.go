@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// writePNG writes an empty image of given size as PNG file to the test
+// temporary directory and returns its path.
+func writePNG(t *testing.T, name string, w, h int) string {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, image.NewNRGBA(image.Rect(0, 0, w, h))); err != nil {
+		t.Fatal(err)
+	}
+	pth := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(pth, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return pth
+}
+
+func Test_Image(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		want := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+		have := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+
+		// --- When ---
+		got := Image(tspy, want, have)
+
+		// --- Then ---
+		affirm.True(t, got)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		want := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+		have := image.NewNRGBA(image.Rect(0, 0, 2, 3))
+
+		// --- When ---
+		got := Image(tspy, want, have)
+
+		// --- Then ---
+		affirm.False(t, got)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		want := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+		have := image.NewNRGBA(image.Rect(0, 0, 2, 3))
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		got := Image(tspy, want, have, opt)
+
+		// --- Then ---
+		affirm.False(t, got)
+	})
+}
+
+func Test_FileImage(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		want := writePNG(t, "want.png", 2, 2)
+		have := writePNG(t, "have.png", 2, 2)
+
+		// --- When ---
+		got := FileImage(tspy, want, have)
+
+		// --- Then ---
+		affirm.True(t, got)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		want := writePNG(t, "want.png", 2, 2)
+		have := writePNG(t, "have.png", 2, 3)
+
+		// --- When ---
+		got := FileImage(tspy, want, have)
+
+		// --- Then ---
+		affirm.False(t, got)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		want := writePNG(t, "want.png", 2, 2)
+		have := writePNG(t, "have.png", 2, 3)
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		got := FileImage(tspy, want, have, opt)
+
+		// --- Then ---
+		affirm.False(t, got)
+	})
+}
@@ -21,3 +21,37 @@ func JSON(t tester.T, want, have string, opts ...check.Option) bool {
 	}
 	return true
 }
+
+// JSONPath asserts the value at JSON "path" in the "doc" document is equal to
+// "want". Returns true if it is, otherwise marks the test as failed, writes
+// error message to test log and returns false.
+//
+//	assert.JSONPath(t, doc, "$.items[0].id", 42)
+func JSONPath(
+	t tester.T,
+	doc, path string,
+	want any,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.JSONPath(doc, path, want, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// JSONSubset asserts the "want" JSON document is a subset of the "have" JSON
+// document. Returns true if it is, otherwise marks the test as failed, writes
+// error message to test log and returns false.
+//
+//	assert.JSONSubset(t, `{"id": "<<UUID>>"}`, have)
+func JSONSubset(t tester.T, want, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.JSONSubset(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
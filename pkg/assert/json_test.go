@@ -61,3 +61,89 @@ func Test_JSON(t *testing.T) {
 		affirm.False(t, got)
 	})
 }
+
+func Test_JSONPath(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		got := JSONPath(tspy, `{"items": [{"id": 1}]}`, "$.items[0].id", 1)
+
+		// --- Then ---
+		affirm.True(t, got)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		got := JSONPath(tspy, `{"items": [{"id": 1}]}`, "$.items[0].id", 2)
+
+		// --- Then ---
+		affirm.False(t, got)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field.items[0].id\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		got := JSONPath(tspy, `{"items": [{"id": 1}]}`, "$.items[0].id", 2, opt)
+
+		// --- Then ---
+		affirm.False(t, got)
+	})
+}
+
+func Test_JSONSubset(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		got := JSONSubset(tspy, `{"a": "<<ANY>>"}`, `{"a": 1, "b": 2}`)
+
+		// --- Then ---
+		affirm.True(t, got)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		got := JSONSubset(tspy, `{"c": 1}`, `{"a": 1, "b": 2}`)
+
+		// --- Then ---
+		affirm.False(t, got)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field.c\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		got := JSONSubset(tspy, `{"c": 1}`, `{"a": 1, "b": 2}`, opt)
+
+		// --- Then ---
+		affirm.False(t, got)
+	})
+}
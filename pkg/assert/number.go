@@ -25,3 +25,77 @@ func Epsilon[T constraints.Number](
 	}
 	return true
 }
+
+// EpsilonRelative asserts the relative difference between two numbers is
+// within a given epsilon. Returns true if it is, otherwise marks the test as
+// failed, writes error message to test log and returns false.
+func EpsilonRelative[T constraints.Float](
+	t tester.T,
+	want, epsilon, have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.EpsilonRelative(want, epsilon, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// WithinULP asserts two floating point numbers are at most "ulp" units in the
+// last place apart. Returns true if they are, otherwise marks the test as
+// failed, writes error message to test log and returns false.
+func WithinULP[T constraints.Float](
+	t tester.T,
+	want T,
+	ulp uint64,
+	have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.WithinULP(want, ulp, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// EpsilonSlice asserts corresponding elements of two slices are within a
+// given delta. Returns true if they are, otherwise marks the test as failed,
+// writes error message to test log and returns false.
+func EpsilonSlice[T constraints.Number](
+	t tester.T,
+	want []T,
+	epsilon T,
+	have []T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.EpsilonSlice(want, epsilon, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// EpsilonComplex asserts the distance between two complex numbers is within a
+// given delta. Returns true if it is, otherwise marks the test as failed,
+// writes error message to test log and returns false.
+func EpsilonComplex[T constraints.Complex](
+	t tester.T,
+	want T,
+	epsilon float64,
+	have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.EpsilonComplex(want, epsilon, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"github.com/ctx42/testing/internal/constraints"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Greater asserts "have" is greater than "want". Returns true if it is,
+// otherwise marks the test as failed, writes error message to test log and
+// returns false.
+func Greater[T constraints.Ordered](
+	t tester.T,
+	want, have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.Greater(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// GreaterOrEqual asserts "have" is greater or equal to "want". Returns true if
+// it is, otherwise marks the test as failed, writes error message to test log
+// and returns false.
+func GreaterOrEqual[T constraints.Ordered](
+	t tester.T,
+	want, have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.GreaterOrEqual(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Less asserts "have" is less than "want". Returns true if it is,
+// otherwise marks the test as failed, writes error message to test log and
+// returns false.
+func Less[T constraints.Ordered](
+	t tester.T,
+	want, have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.Less(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// LessOrEqual asserts "have" is less or equal to "want". Returns true if it
+// is, otherwise marks the test as failed, writes error message to test log and
+// returns false.
+func LessOrEqual[T constraints.Ordered](
+	t tester.T,
+	want, have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.LessOrEqual(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Between asserts "have" is in the closed range from "lo" to "hi".
+// Returns true if it is, otherwise marks the test as failed, writes error
+// message to test log and returns false.
+func Between[T constraints.Ordered](
+	t tester.T,
+	lo, hi, have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.Between(lo, hi, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Positive asserts "have" is greater than zero. Returns true if it is, otherwise
+// marks the test as failed, writes error message to test log and returns
+// false.
+func Positive[T constraints.Number](
+	t tester.T,
+	have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.Positive(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Negative asserts "have" is less than zero. Returns true if it is, otherwise
+// marks the test as failed, writes error message to test log and returns
+// false. For unsigned types it always fails.
+func Negative[T constraints.Number](
+	t tester.T,
+	have T,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.Negative(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// RoundTripJSON asserts "have" value marshaled to JSON and unmarshalled to a
+// new value of the same type is equal to "have". Returns true if it is,
+// otherwise marks the test as failed, writes error message to test log and
+// returns false.
+func RoundTripJSON(t tester.T, have any, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.RoundTripJSON(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// RoundTripText asserts "have" value marshaled with [encoding.TextMarshaler]
+// and unmarshalled to a new value of the same type is equal to "have". Returns
+// true if it is, otherwise marks the test as failed, writes error message to
+// test log and returns false.
+func RoundTripText(t tester.T, have any, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.RoundTripText(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// RoundTripBinary asserts "have" value marshaled with
+// [encoding.BinaryMarshaler] and unmarshalled to a new value of the same type
+// is equal to "have". Returns true if it is, otherwise marks the test as
+// failed, writes error message to test log and returns false.
+func RoundTripBinary(t tester.T, have any, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.RoundTripBinary(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// RoundTripGob asserts "have" value encoded with [gob] and decoded to a new
+// value of the same type is equal to "have". Returns true if it is, otherwise
+// marks the test as failed, writes error message to test log and returns false.
+func RoundTripGob(t tester.T, have any, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.RoundTripGob(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// RoundTripXML asserts "have" value marshaled to XML and unmarshalled to a new
+// value of the same type is equal to "have". Returns true if it is, otherwise
+// marks the test as failed, writes error message to test log and returns false.
+func RoundTripXML(t tester.T, have any, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.RoundTripXML(have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"iter"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// SeqHas asserts iterator yields "want" value. Returns true if it does,
+// otherwise marks the test as failed, writes error message to test log and
+// returns false.
+func SeqHas[T comparable](
+	t tester.T,
+	want T,
+	seq iter.Seq[T],
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.SeqHas(want, seq, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// SeqHasNo asserts iterator does not yield "want" value. Returns true if it
+// doesn't, otherwise marks the test as failed, writes error message to test
+// log and returns false.
+func SeqHasNo[T comparable](
+	t tester.T,
+	want T,
+	seq iter.Seq[T],
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.SeqHasNo(want, seq, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// SeqElementsMatch asserts iterator yields the same elements as "want" slice
+// regardless of their order. Returns true if it does, otherwise marks the test
+// as failed, writes error message to test log and returns false.
+func SeqElementsMatch[T any](
+	t tester.T,
+	want []T,
+	have iter.Seq[T],
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.SeqElementsMatch(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
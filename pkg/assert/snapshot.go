@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"runtime"
+
+	"github.com/ctx42/testing/internal/inline"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/golden"
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// pkgPath is the import path of this package.
+const pkgPath = "github.com/ctx42/testing/pkg/assert"
+
+// InlineSnapshot asserts "have" rendered with [dump.Dump] is equal to the
+// "want" snapshot. Returns true if it is, otherwise marks the test as failed,
+// writes error message to test log and returns false.
+//
+// In update mode, instead of failing, the string literal passed as "want" is
+// rewritten in the test source file with the actual value. The update mode is
+// turned on with [check.WithUpdateInline] option or as described in
+// [golden.Updating].
+//
+//	assert.InlineSnapshot(t, have, `{
+//	  Name: "Alice",
+//	}`)
+func InlineSnapshot(
+	t tester.T,
+	have any,
+	want string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	_, pth, line, _ := runtime.Caller(1)
+	return inlineSnapshot(t, pth, line, have, want, opts...)
+}
+
+// inlineSnapshot implements [InlineSnapshot] called at the "line" of the
+// "pth" source file.
+func inlineSnapshot(
+	t tester.T,
+	pth string,
+	line int,
+	have any,
+	want string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	e := check.InlineSnapshot(want, have, opts...)
+	if e == nil {
+		return true
+	}
+	ops := check.DefaultOptions(opts...)
+	if !ops.UpdateInline && !golden.Updating() {
+		t.Error(e)
+		return false
+	}
+	val := ops.Dumper.Any(have)
+	err := inline.Rewrite(pth, line, pkgPath, "InlineSnapshot", 2, val)
+	if err != nil {
+		msg := notice.New("expected no error updating inline snapshot").
+			Trail(ops.Trail).
+			Append("path", "%s:%d", pth, line).
+			Append("error", "%s", err)
+		t.Error(msg)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/golden"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_InlineSnapshot(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		got := InlineSnapshot(tspy, []int{1, 2}, `[]int{
+  1,
+  2,
+}`)
+
+		// --- Then ---
+		affirm.True(t, got)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(golden.EnvUpdate, "")
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		got := InlineSnapshot(tspy, "xyz", `"abc"`)
+
+		// --- Then ---
+		affirm.False(t, got)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(golden.EnvUpdate, "")
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field\n")
+		tspy.Close()
+
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		got := InlineSnapshot(tspy, "xyz", `"abc"`, opt)
+
+		// --- Then ---
+		affirm.False(t, got)
+	})
+}
+
+func Test_inlineSnapshot(t *testing.T) {
+	t.Run("update with option", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pth := writeSnapshotTest(t)
+
+		// --- When ---
+		got := inlineSnapshot(tspy, pth, 11, []int{1, 2}, "", check.WithUpdateInline)
+
+		// --- Then ---
+		affirm.True(t, got)
+		want := snapshotHeader +
+			"func Test_Example(t *testing.T) {\n" +
+			"\tassert.InlineSnapshot(t, have, `[]int{\n" +
+			"  1,\n" +
+			"  2,\n" +
+			"}`)\n" +
+			"}\n"
+		affirm.Equal(t, want, readSnapshotTest(t, pth))
+	})
+
+	t.Run("update with environment variable", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(golden.EnvUpdate, "true")
+		tspy := tester.New(t).Close()
+		pth := writeSnapshotTest(t)
+
+		// --- When ---
+		got := inlineSnapshot(tspy, pth, 11, "abc", "")
+
+		// --- Then ---
+		affirm.True(t, got)
+		want := snapshotHeader +
+			"func Test_Example(t *testing.T) {\n" +
+			"\tassert.InlineSnapshot(t, have, `\"abc\"`)\n" +
+			"}\n"
+		affirm.Equal(t, want, readSnapshotTest(t, pth))
+	})
+
+	t.Run("no update when equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pth := writeSnapshotTest(t)
+
+		// --- When ---
+		got := inlineSnapshot(tspy, pth, 11, "", `""`, check.WithUpdateInline)
+
+		// --- Then ---
+		affirm.True(t, got)
+		affirm.Equal(t, snapshotTest, readSnapshotTest(t, pth))
+	})
+
+	t.Run("no update with directory update option", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(golden.EnvUpdate, "")
+		pth := writeSnapshotTest(t)
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		// --- When ---
+		got := inlineSnapshot(tspy, pth, 11, "abc", "", check.WithUpdateDir)
+
+		// --- Then ---
+		affirm.False(t, got)
+		affirm.Equal(t, snapshotTest, readSnapshotTest(t, pth))
+	})
+
+	t.Run("check package call is not rewritten", func(t *testing.T) {
+		// --- Given ---
+		src := snapshotHeader +
+			"func Test_Example(t *testing.T) {\n" +
+			"\tassert.InlineSnapshot(t, have, \"\"); " +
+			"_ = check.InlineSnapshot(\"\", have)\n" +
+			"}\n"
+		pth := writeSnapshotSource(t, src)
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		got := inlineSnapshot(tspy, pth, 11, "abc", "", check.WithUpdateInline)
+
+		// --- Then ---
+		affirm.True(t, got)
+		want := snapshotHeader +
+			"func Test_Example(t *testing.T) {\n" +
+			"\tassert.InlineSnapshot(t, have, `\"abc\"`)\n" +
+			"\t_ = check.InlineSnapshot(\"\", have)\n" +
+			"}\n"
+		affirm.Equal(t, want, readSnapshotTest(t, pth))
+	})
+
+	t.Run("error only check package call", func(t *testing.T) {
+		// --- Given ---
+		src := snapshotHeader +
+			"func Test_Example(t *testing.T) {\n" +
+			"\t_ = check.InlineSnapshot(\"\", have)\n" +
+			"}\n"
+		pth := writeSnapshotSource(t, src)
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain(
+			"  error: no call to " + pkgPath + ".InlineSnapshot at line 11",
+		)
+		tspy.Close()
+
+		// --- When ---
+		got := inlineSnapshot(tspy, pth, 11, "abc", "", check.WithUpdateInline)
+
+		// --- Then ---
+		affirm.False(t, got)
+		affirm.Equal(t, src, readSnapshotTest(t, pth))
+	})
+
+	t.Run("error updating", func(t *testing.T) {
+		// --- Given ---
+		pth := writeSnapshotTest(t)
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual(
+			"expected no error updating inline snapshot:\n" +
+				"  trail: type.field\n" +
+				"   path: " + pth + ":3\n" +
+				"  error: no call to " + pkgPath + ".InlineSnapshot at line 3",
+		)
+		tspy.Close()
+
+		opts := []check.Option{check.WithUpdateInline, check.WithTrail("type.field")}
+
+		// --- When ---
+		got := inlineSnapshot(tspy, pth, 3, "abc", "", opts...)
+
+		// --- Then ---
+		affirm.False(t, got)
+		affirm.Equal(t, snapshotTest, readSnapshotTest(t, pth))
+	})
+}
+
+// snapshotHeader is the header of test source files.
+const snapshotHeader = "package example\n" +
+	"\n" +
+	"import (\n" +
+	"\t\"testing\"\n" +
+	"\n" +
+	"\t\"github.com/ctx42/testing/pkg/assert\"\n" +
+	"\t\"github.com/ctx42/testing/pkg/check\"\n" +
+	")\n" +
+	"\n"
+
+// snapshotTest is a test source file with [InlineSnapshot] call at line 11.
+const snapshotTest = snapshotHeader +
+	"func Test_Example(t *testing.T) {\n" +
+	"\tassert.InlineSnapshot(t, have, ``)\n" +
+	"}\n"
+
+// writeSnapshotTest writes [snapshotTest] to a temporary file and returns its
+// path.
+func writeSnapshotTest(t *testing.T) string {
+	t.Helper()
+	return writeSnapshotSource(t, snapshotTest)
+}
+
+// writeSnapshotSource writes test source file to a temporary file and returns
+// its path.
+func writeSnapshotSource(t *testing.T, src string) string {
+	t.Helper()
+	pth := filepath.Join(t.TempDir(), "example_test.go")
+	affirm.Nil(t, os.WriteFile(pth, []byte(src), 0o600))
+	return pth
+}
+
+// readSnapshotTest returns contents of the test source file at "pth".
+func readSnapshotTest(t *testing.T, pth string) string {
+	t.Helper()
+	data, err := os.ReadFile(pth)
+	affirm.Nil(t, err)
+	return string(data)
+}
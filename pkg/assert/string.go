@@ -31,3 +31,115 @@ func NotContain(t tester.T, want, have string, opts ...check.Option) bool {
 	}
 	return true
 }
+
+// ContainAll asserts all strings in "want" are substrings of "have". Returns true
+// if they are, otherwise marks the test as failed, writes error message to
+// test log and returns false.
+func ContainAll(
+	t tester.T,
+	want []string, have string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.ContainAll(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// ContainAny asserts at least one of the strings in "want" is a substring
+// of "have". Returns true if it is, otherwise marks the test as failed, writes
+// error message to test log and returns false.
+func ContainAny(
+	t tester.T,
+	want []string, have string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.ContainAny(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// HasPrefix asserts "have" begins with "want". Returns true if it does,
+// otherwise marks the test as failed, writes error message to test log and
+// returns false.
+func HasPrefix(t tester.T, want, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.HasPrefix(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// HasSuffix asserts "have" ends with "want". Returns true if it does,
+// otherwise marks the test as failed, writes error message to test log and
+// returns false.
+func HasSuffix(t tester.T, want, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.HasSuffix(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// EqualFold asserts "want" and "have" are equal under simple Unicode
+// case-folding. Returns true if they are, otherwise marks the test as failed,
+// writes error message to test log and returns false.
+func EqualFold(t tester.T, want, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.EqualFold(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// EqualTrimmed asserts "want" and "have" are equal after removing leading
+// and trailing white space. Returns true if they are, otherwise marks the test
+// as failed, writes error message to test log and returns false.
+func EqualTrimmed(t tester.T, want, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.EqualTrimmed(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// EqualIgnoringWhitespace asserts "want" and "have" are equal after
+// removing all white space characters. Returns true if they are, otherwise
+// marks the test as failed, writes error message to test log and returns
+// false.
+func EqualIgnoringWhitespace(
+	t tester.T,
+	want, have string,
+	opts ...check.Option,
+) bool {
+
+	t.Helper()
+	if e := check.EqualIgnoringWhitespace(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// LinesEqual asserts "want" and "have" consist of the same lines ignoring
+// line ending differences. Returns true if they are, otherwise marks the test
+// as failed, writes error message to test log and returns false.
+func LinesEqual(t tester.T, want, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.LinesEqual(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
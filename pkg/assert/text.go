@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// TextMatch asserts "have" text matches the doctest style "pattern". Returns
+// true if it does, otherwise marks the test as failed, writes error message to
+// test log and returns false. See [check.TextMatch] for the pattern syntax.
+func TextMatch(t tester.T, pattern, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.TextMatch(pattern, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// Implements asserts the type of "have" implements interface "I". Returns
+// true if it does, otherwise marks the test as failed, writes error message
+// to test log and returns false.
+func Implements[I any](t tester.T, have any, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.Implements[I](have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// NotImplements asserts the type of "have" does not implement interface "I".
+// Returns true if it doesn't, otherwise marks the test as failed, writes
+// error message to test log and returns false.
+func NotImplements[I any](t tester.T, have any, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.NotImplements[I](have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// AssignableTo asserts the value "have" is assignable to a variable of type
+// "T". Returns true if it is, otherwise marks the test as failed, writes
+// error message to test log and returns false.
+func AssignableTo[T any](t tester.T, have any, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.AssignableTo[T](have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// ConvertibleTo asserts the value "have" is convertible to type "T". Returns
+// true if it is, otherwise marks the test as failed, writes error message to
+// test log and returns false.
+func ConvertibleTo[T any](t tester.T, have any, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.ConvertibleTo[T](have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// XML asserts that two XML strings are equivalent. Returns true if they are,
+// otherwise marks the test as failed, writes error message to test log and
+// returns false.
+//
+//	assert.XML(t, `<a x="1" y="2"/>`, `<a y="2" x="1"></a>`)
+func XML(t tester.T, want, have string, opts ...check.Option) bool {
+	t.Helper()
+	if e := check.XML(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
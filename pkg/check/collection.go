@@ -4,25 +4,40 @@
 package check
 
 import (
+	"cmp"
 	"errors"
+	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/ctx42/testing/internal/constraints"
 	"github.com/ctx42/testing/internal/core"
 	"github.com/ctx42/testing/pkg/notice"
 )
 
 // Len checks "have" has "want" elements. Returns nil if it has, otherwise it
 // returns an error with a message indicating the expected and actual values.
+//
+// For iterators, the number of yielded items is checked. Iterators yielding
+// more than [core.SeqLimit] items are reported as errors.
 func Len(want int, have any, opts ...Option) error {
 	cnt, ok := core.Len(have)
 	if !ok {
+		if core.IsSeq(have) {
+			ops := DefaultOptions(opts...)
+			return seqLimitError(ops)
+		}
 		return notice.New("cannot execute len(%T)", have)
 	}
 	if want != cnt {
 		ops := DefaultOptions(opts...)
-		msg := notice.New("expected %T length", have).
+		header := fmt.Sprintf("expected %T length", have)
+		if core.IsSeq(have) {
+			header = "expected iterator length"
+		}
+		msg := notice.New(header).
 			Trail(ops.Trail).
 			Want("%d", want).
 			Have("%d", cnt)
@@ -212,3 +227,485 @@ func MapsSubset[K comparable, V any](want, have []map[K]V, opts ...Option) error
 	}
 	return wrap(errors.Join(ers...))
 }
+
+// ElementsMatch checks "want" and "have" slices have the same elements
+// regardless of their order. Elements are compared using [Equal] rules and
+// each element is matched at most once, so the number of duplicates must be
+// the same in both slices. Returns nil if they do, otherwise it returns an
+// error with a message indicating the missing and unexpected values.
+func ElementsMatch[T any](want, have []T, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	used := make([]bool, len(have))
+	var missing []T
+	for _, wVal := range want {
+		found := false
+		for i, hVal := range have {
+			if used[i] {
+				continue
+			}
+			if Equal(wVal, hVal, WithOptions(ops)) == nil {
+				used[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, wVal)
+		}
+	}
+	var extra []T
+	for i, hVal := range have {
+		if !used[i] {
+			extra = append(extra, hVal)
+		}
+	}
+	if len(missing) == 0 && len(extra) == 0 {
+		return nil
+	}
+
+	msg := notice.New("expected slices to have the same elements").
+		Trail(ops.Trail)
+	if len(missing) > 0 {
+		_ = msg.Append("missing", "%s", ops.Dumper.Any(missing))
+	}
+	if len(extra) > 0 {
+		_ = msg.Append("unexpected", "%s", ops.Dumper.Any(extra))
+	}
+	return msg
+}
+
+// Unique checks "have" slice has no duplicate values. Returns nil if it
+// doesn't, otherwise it returns an error with a message indicating the indexes
+// of the first duplicate pair. When "T" is an interface type and any of the
+// values is not hashable (for example a slice), the values are compared
+// using [NoDuplicates].
+func Unique[T comparable](have []T, opts ...Option) error {
+	if !hashable(have) {
+		return NoDuplicates(have, opts...)
+	}
+	seen := make(map[T]int, len(have))
+	for i, val := range have {
+		if j, ok := seen[val]; ok {
+			return duplicateError(j, i, have, opts...)
+		}
+		seen[val] = i
+	}
+	return nil
+}
+
+// hashable returns true if all values of the slice can be used as map keys.
+// Only values of interface types may not be hashable.
+func hashable[T comparable](have []T) bool {
+	if reflect.TypeFor[T]().Kind() != reflect.Interface {
+		return true
+	}
+	for _, val := range have {
+		if v := reflect.ValueOf(val); v.IsValid() && !v.Comparable() {
+			return false
+		}
+	}
+	return true
+}
+
+// NoDuplicates checks "have" slice has no duplicate values. Unlike [Unique]
+// it compares elements using [Equal] rules, so it works for any element type.
+// Returns nil if there are no duplicates, otherwise it returns an error with a
+// message indicating the indexes of the first duplicate pair.
+func NoDuplicates[T any](have []T, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	for i := 1; i < len(have); i++ {
+		for j := range i {
+			if Equal(have[j], have[i], WithOptions(ops)) == nil {
+				return duplicateError(j, i, have, opts...)
+			}
+		}
+	}
+	return nil
+}
+
+// Sorted checks "have" slice is sorted in ascending order. Equal neighbouring
+// values are allowed. Returns nil if it is, otherwise it returns an error with
+// a message indicating the first pair of values out of order.
+func Sorted[T constraints.Ordered](have []T, opts ...Option) error {
+	for i := 1; i < len(have); i++ {
+		if cmp.Less(have[i], have[i-1]) {
+			return orderError("sorted", i, have, opts...)
+		}
+	}
+	return nil
+}
+
+// SortedFunc checks "have" slice is sorted in ascending order as defined by
+// the "compare" function. The function should return a negative number when
+// a < b, a positive number when a > b and zero when a == b. Returns nil if it
+// is, otherwise it returns an error with a message indicating the first pair
+// of values out of order.
+func SortedFunc[T any](have []T, compare func(a, b T) int, opts ...Option) error {
+	for i := 1; i < len(have); i++ {
+		if compare(have[i], have[i-1]) < 0 {
+			return orderError("sorted", i, have, opts...)
+		}
+	}
+	return nil
+}
+
+// StrictlyIncreasing checks every element of "have" slice is greater than the
+// previous one. Returns nil if it is, otherwise it returns an error with a
+// message indicating the first pair of values out of order.
+func StrictlyIncreasing[T constraints.Ordered](have []T, opts ...Option) error {
+	for i := 1; i < len(have); i++ {
+		if !cmp.Less(have[i-1], have[i]) {
+			return orderError("strictly increasing", i, have, opts...)
+		}
+	}
+	return nil
+}
+
+// Decreasing checks every element of "have" slice is less or equal to the
+// previous one. Returns nil if it is, otherwise it returns an error with a
+// message indicating the first pair of values out of order.
+func Decreasing[T constraints.Ordered](have []T, opts ...Option) error {
+	for i := 1; i < len(have); i++ {
+		if cmp.Less(have[i-1], have[i]) {
+			return orderError("decreasing", i, have, opts...)
+		}
+	}
+	return nil
+}
+
+// duplicateError returns error for slice with the same values at indexes "i"
+// and "j".
+func duplicateError[T any](i, j int, have []T, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	return notice.New("expected slice to have unique values").
+		Trail(ops.Trail).
+		Append(fmt.Sprintf("[%d]", i), "%s", ops.Dumper.Any(have[i])).
+		Append(fmt.Sprintf("[%d]", j), "%s", ops.Dumper.Any(have[j])).
+		Append("slice", "%s", ops.Dumper.Any(have))
+}
+
+// orderError returns error for slice with values at index "i-1" and "i" not
+// in the expected order.
+func orderError[T any](order string, i int, have []T, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	return notice.New("expected slice to be %s", order).
+		Trail(ops.Trail).
+		Append(fmt.Sprintf("[%d]", i-1), "%s", ops.Dumper.Any(have[i-1])).
+		Append(fmt.Sprintf("[%d]", i), "%s", ops.Dumper.Any(have[i])).
+		Append("slice", "%s", ops.Dumper.Any(have))
+}
+
+// Each runs "chk" against every element of a slice or an array, or every
+// value of a map. Each element is checked with the trail pointing at it, so
+// errors returned by "chk" carry element trails like "<slice>[3].Total".
+// Map values are visited in key order. Returns nil if all elements pass the
+// check, otherwise it returns an error with all the errors returned by "chk".
+//
+// Example:
+//
+//	err := check.Each(orders, check.NotZero)
+func Each[T any](
+	have any,
+	chk func(have T, opts ...Option) error,
+	opts ...Option,
+) error {
+
+	ops := DefaultOptions(opts...)
+	els, err := elements[T](have, ops)
+	if err != nil {
+		return err
+	}
+	var ers []error
+	for _, el := range els {
+		eOps := ops
+		eOps.Trail = el.trail
+		if err = chk(el.val, WithOptions(eOps)); err != nil {
+			ers = append(ers, notice.Unwrap(err)...)
+		}
+	}
+	return wrap(errors.Join(ers...))
+}
+
+// AnyMatch checks at least one element of a slice, an array or a map value
+// matches the predicate. Returns nil if it does, otherwise it returns an error
+// with a message indicating the actual value.
+func AnyMatch[T any](have any, pred func(have T) bool, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	els, err := elements[T](have, ops)
+	if err != nil {
+		return err
+	}
+	for _, el := range els {
+		if pred(el.val) {
+			return nil
+		}
+	}
+	return notice.New("expected at least one element to match predicate").
+		Trail(ops.Trail).
+		Have("%s", ops.Dumper.Any(have))
+}
+
+// NoneMatch checks no element of a slice, an array or a map value matches the
+// predicate. Returns nil if none does, otherwise it returns an error for every
+// matching element with a message indicating the element trail and value.
+func NoneMatch[T any](have any, pred func(have T) bool, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	els, err := elements[T](have, ops)
+	if err != nil {
+		return err
+	}
+	var ers []error
+	for _, el := range els {
+		if pred(el.val) {
+			msg := notice.New("expected no element to match predicate").
+				Trail(el.trail).
+				Have("%s", ops.Dumper.Any(el.val))
+			ers = append(ers, msg)
+		}
+	}
+	return wrap(errors.Join(ers...))
+}
+
+// CountFunc checks "want" number of elements of a slice, an array or a map
+// value match the predicate. Returns nil if they do, otherwise it returns an
+// error with a message indicating the expected and actual counts and the
+// trails of matching elements.
+func CountFunc[T any](
+	want int,
+	have any,
+	pred func(have T) bool,
+	opts ...Option,
+) error {
+
+	ops := DefaultOptions(opts...)
+	els, err := elements[T](have, ops)
+	if err != nil {
+		return err
+	}
+	var matching []string
+	for _, el := range els {
+		if pred(el.val) {
+			matching = append(matching, el.trail)
+		}
+	}
+	if want == len(matching) {
+		return nil
+	}
+
+	msg := notice.New("expected number of elements matching predicate").
+		Trail(ops.Trail).
+		Want("%d", want).
+		Have("%d", len(matching))
+	if len(matching) > 0 {
+		_ = msg.Append("matching", "%s", strings.Join(matching, "\n"))
+	}
+	return msg
+}
+
+// MapKeys checks "have" map has exactly the "want" keys regardless of their
+// order. Returns nil if it does, otherwise it returns an error with a message
+// listing missing and unexpected keys.
+func MapKeys[K comparable, V any](want []K, have map[K]V, opts ...Option) error {
+	set := make(map[K]struct{}, len(want))
+	var missing []K
+	for _, key := range want {
+		if _, ok := set[key]; ok {
+			continue
+		}
+		set[key] = struct{}{}
+		if _, ok := have[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	var extra []K
+	for key := range have {
+		if _, ok := set[key]; !ok {
+			extra = append(extra, key)
+		}
+	}
+	if len(missing) == 0 && len(extra) == 0 {
+		return nil
+	}
+
+	ops := DefaultOptions(opts...)
+	msg := notice.New("expected map to have keys").Trail(ops.Trail)
+	if len(missing) > 0 {
+		_ = msg.Append("missing key(s)", "%s", keysString(missing))
+	}
+	if len(extra) > 0 {
+		_ = msg.Append("unexpected key(s)", "%s", keysString(extra))
+	}
+	return msg
+}
+
+// MapHasKeys checks "have" map has all the "want" keys. It is not an error
+// when "have" map has some other keys. Returns nil if it does, otherwise it
+// returns an error with a message listing missing keys.
+func MapHasKeys[K comparable, V any](
+	want []K,
+	have map[K]V,
+	opts ...Option,
+) error {
+
+	var missing []K
+	for _, key := range want {
+		if _, ok := have[key]; !ok && !slices.Contains(missing, key) {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return notice.New(`expected "have" map to have key(s)`).
+		Trail(ops.Trail).
+		Append("missing key(s)", "%s", keysString(missing))
+}
+
+// MapValuesMatch checks "have" map values are the same as "want" values
+// regardless of their order using [ElementsMatch]. Returns nil if they are,
+// otherwise it returns an error with a message indicating the missing and
+// unexpected values.
+func MapValuesMatch[K comparable, V any](
+	want []V,
+	have map[K]V,
+	opts ...Option,
+) error {
+
+	vals := make([]V, 0, len(have))
+	for _, key := range sortedKeys(have) {
+		vals = append(vals, have[key])
+	}
+	if err := ElementsMatch(want, vals, opts...); err != nil {
+		var msg *notice.Notice
+		if errors.As(err, &msg) {
+			return msg.SetHeader("expected map to have the same values")
+		}
+		return err
+	}
+	return nil
+}
+
+// MapEach calls "fn" for each key and value of "have" map in key order.
+// Notices returned by "fn" without a trail get the entry trail like
+// map["key"], other errors are wrapped in a notice with the entry trail.
+// Returns nil if "fn" returns nil for all entries, otherwise it returns an
+// error with all the errors returned by "fn".
+//
+// Example:
+//
+//	err := check.MapEach(prices, func(k string, v float64) error {
+//		return check.True(v > 0)
+//	})
+func MapEach[K comparable, V any](
+	have map[K]V,
+	fn func(k K, v V) error,
+	opts ...Option,
+) error {
+
+	ops := DefaultOptions(opts...)
+	var ers []error
+	for _, key := range sortedKeys(have) {
+		err := fn(key, have[key])
+		if err == nil {
+			continue
+		}
+		trail := ops.mapTrail(valToString(reflect.ValueOf(key)))
+		for _, e := range notice.Unwrap(err) {
+			var msg *notice.Notice
+			if !errors.As(e, &msg) {
+				msg = notice.New("expected map entry to pass the check").
+					Append("error", "%s", e).
+					Wrap(e)
+			}
+			if _, ok := msg.Rows["trail"]; !ok {
+				_ = msg.Trail(trail)
+			}
+			ers = append(ers, msg)
+		}
+	}
+	return wrap(errors.Join(ers...))
+}
+
+// sortedKeys returns map keys sorted using [core.ValueCmp].
+func sortedKeys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sortKeys(keys)
+	return keys
+}
+
+// sortKeys sorts map keys in place using [core.ValueCmp].
+func sortKeys[K comparable](keys []K) {
+	slices.SortStableFunc(keys, func(a, b K) int {
+		return core.ValueCmp(reflect.ValueOf(a), reflect.ValueOf(b))
+	})
+}
+
+// keysString returns comma separated list of sorted map keys.
+func keysString[K comparable](keys []K) string {
+	sortKeys(keys)
+	strs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		strs = append(strs, valToString(reflect.ValueOf(key)))
+	}
+	return strings.Join(strs, ", ")
+}
+
+// element represents collection element and its trail.
+type element[T any] struct {
+	trail string // Element trail.
+	val   T      // Element value.
+}
+
+// elements returns elements of a slice, an array or values of a map. Map
+// values are ordered by keys. Returns error if "have" is not a collection or
+// its elements are not of type T.
+func elements[T any](have any, ops Options) ([]element[T], error) {
+	hVal := reflect.ValueOf(have)
+	var vals []reflect.Value
+	var trails []string
+	switch knd := hVal.Kind(); knd {
+	case reflect.Slice, reflect.Array:
+		for i := range hVal.Len() {
+			vals = append(vals, hVal.Index(i))
+			trails = append(trails, ops.arrTrail(knd.String(), i))
+		}
+
+	case reflect.Map:
+		keys := hVal.MapKeys()
+		// Interface keys are compared by the values they hold.
+		slices.SortStableFunc(keys, core.ValueCmp)
+		for _, key := range keys {
+			vals = append(vals, hVal.MapIndex(key))
+			if key.Kind() == reflect.Interface {
+				key = key.Elem()
+			}
+			trails = append(trails, ops.mapTrail(valToString(key)))
+		}
+
+	default:
+		return nil, notice.New("expected slice, array or map").
+			Trail(ops.Trail).
+			Append("got type", "%T", have)
+	}
+
+	typ := reflect.TypeFor[T]()
+	els := make([]element[T], 0, len(vals))
+	for i, val := range vals {
+		var el T
+		ok := isNillable(typ)
+		if itf := val.Interface(); itf != nil {
+			el, ok = itf.(T)
+		}
+		if !ok {
+			return nil, notice.New("expected elements of type %s", typ).
+				Trail(trails[i]).
+				Append("got type", "%T", val.Interface())
+		}
+		els = append(els, element[T]{trail: trails[i], val: el})
+	}
+	return els, nil
+}
@@ -4,9 +4,13 @@
 package check
 
 import (
+	"errors"
+	"maps"
+	"slices"
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
 )
 
 func Test_Len(t *testing.T) {
@@ -46,6 +50,8 @@ func Test_Len_success_tabular(t *testing.T) {
 		{"map success", map[string]int{"A": 1}, 1},
 		{"channel success", ch, 3},
 		{"string success", "abc", 3},
+		{"iter.Seq success", slices.Values([]int{1, 2}), 2},
+		{"iter.Seq2 success", maps.All(map[int]int{1: 1}), 1},
 	}
 
 	for _, tc := range tt {
@@ -66,6 +72,11 @@ func Test_Len_error_tabular(t *testing.T) {
 	ch <- 2
 	t.Cleanup(func() { <-ch; <-ch; <-ch; close(ch) })
 
+	infSeq := func(yield func(int) bool) {
+		for yield(0) {
+		}
+	}
+
 	tt := []struct {
 		testN string
 
@@ -80,6 +91,8 @@ func Test_Len_error_tabular(t *testing.T) {
 		{"invalid type", 1, 0, "cannot execute len(int)"},
 		{"chan fail", ch, 4, "expected chan int length:\n  want: 4\n  have: 3"},
 		{"string fail", "abc", 4, "expected string length:\n  want: 4\n  have: 3"},
+		{"iter.Seq fail", slices.Values([]int{1}), 2, "expected iterator length:\n  want: 2\n  have: 1"},
+		{"iter.Seq over limit", infSeq, 0, "expected iterator to yield at most 1000 items"},
 	}
 
 	for _, tc := range tt {
@@ -650,3 +663,1243 @@ func Test_MapsSubset(t *testing.T) {
 		affirm.Equal(t, wMsg, err.Error())
 	})
 }
+
+func Test_ElementsMatch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := ElementsMatch([]int{1, 2, 2, 3}, []int{2, 3, 1, 2})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - both empty", func(t *testing.T) {
+		// --- When ---
+		err := ElementsMatch([]int{}, nil)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - deep equal", func(t *testing.T) {
+		// --- Given ---
+		want := []*types.TPtr{{Val: "A"}, {Val: "B"}}
+		have := []*types.TPtr{{Val: "B"}, {Val: "A"}}
+
+		// --- When ---
+		err := ElementsMatch(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - different duplicates count", func(t *testing.T) {
+		// --- When ---
+		err := ElementsMatch([]int{1, 2, 2}, []int{1, 1, 2})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slices to have the same elements:\n" +
+			"     missing:\n" +
+			"              []int{\n" +
+			"                2,\n" +
+			"              }\n" +
+			"  unexpected:\n" +
+			"              []int{\n" +
+			"                1,\n" +
+			"              }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - missing", func(t *testing.T) {
+		// --- When ---
+		err := ElementsMatch([]string{"a", "b"}, []string{"a"})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slices to have the same elements:\n" +
+			"  missing:\n" +
+			"           []string{\n" +
+			"             \"b\",\n" +
+			"           }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := ElementsMatch([]int{1}, []int{1, 2}, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slices to have the same elements:\n" +
+			"       trail: type.field\n" +
+			"  unexpected:\n" +
+			"              []int{\n" +
+			"                2,\n" +
+			"              }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Unique(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := Unique([]int{1, 2, 3})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - nil", func(t *testing.T) {
+		// --- When ---
+		err := Unique[int](nil)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - unhashable values", func(t *testing.T) {
+		// --- When ---
+		err := Unique([]any{[]int{1}, []int{2}, nil, 1})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - unhashable values", func(t *testing.T) {
+		// --- When ---
+		err := Unique([]any{[]int{1}, []int{1}})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to have unique values:\n" +
+			"    [0]:\n" +
+			"         []int{\n" +
+			"           1,\n" +
+			"         }\n" +
+			"    [1]:\n" +
+			"         []int{\n" +
+			"           1,\n" +
+			"         }\n" +
+			"  slice:\n" +
+			"         []any{\n" +
+			"           {\n" +
+			"             1,\n" +
+			"           },\n" +
+			"           {\n" +
+			"             1,\n" +
+			"           },\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - unhashable nested values", func(t *testing.T) {
+		// --- Given ---
+		type T struct{ V any }
+
+		// --- When ---
+		err := Unique([]any{T{V: []int{1}}, T{V: []int{1}}})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := Unique([]string{"a", "b", "c", "b", "a"})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to have unique values:\n" +
+			"    [1]: \"b\"\n" +
+			"    [3]: \"b\"\n" +
+			"  slice:\n" +
+			"         []string{\n" +
+			"           \"a\",\n" +
+			"           \"b\",\n" +
+			"           \"c\",\n" +
+			"           \"b\",\n" +
+			"           \"a\",\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Unique([]int{1, 1}, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to have unique values:\n" +
+			"  trail: type.field\n" +
+			"    [0]: 1\n" +
+			"    [1]: 1\n" +
+			"  slice:\n" +
+			"         []int{\n" +
+			"           1,\n" +
+			"           1,\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_NoDuplicates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		have := []*types.TPtr{{Val: "A"}, {Val: "B"}}
+
+		// --- When ---
+		err := NoDuplicates(have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		have := [][]int{{1}, {2}, {1}}
+
+		// --- When ---
+		err := NoDuplicates(have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to have unique values:\n" +
+			"    [0]:\n" +
+			"         []int{\n" +
+			"           1,\n" +
+			"         }\n" +
+			"    [2]:\n" +
+			"         []int{\n" +
+			"           1,\n" +
+			"         }\n" +
+			"  slice:\n" +
+			"         [][]int{\n" +
+			"           {\n" +
+			"             1,\n" +
+			"           },\n" +
+			"           {\n" +
+			"             2,\n" +
+			"           },\n" +
+			"           {\n" +
+			"             1,\n" +
+			"           },\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := NoDuplicates([]any{1, "a", 1}, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to have unique values:\n" +
+			"  trail: type.field\n" +
+			"    [0]: 1\n" +
+			"    [2]: 1\n" +
+			"  slice:\n" +
+			"         []any{\n" +
+			"           1,\n" +
+			"           \"a\",\n" +
+			"           1,\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Sorted(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := Sorted([]int{1, 2, 2, 3})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - empty", func(t *testing.T) {
+		// --- When ---
+		err := Sorted([]string{})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := Sorted([]int{1, 3, 2})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to be sorted:\n" +
+			"    [1]: 3\n" +
+			"    [2]: 2\n" +
+			"  slice:\n" +
+			"         []int{\n" +
+			"           1,\n" +
+			"           3,\n" +
+			"           2,\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Sorted([]string{"b", "a"}, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to be sorted:\n" +
+			"  trail: type.field\n" +
+			"    [0]: \"b\"\n" +
+			"    [1]: \"a\"\n" +
+			"  slice:\n" +
+			"         []string{\n" +
+			"           \"b\",\n" +
+			"           \"a\",\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_SortedFunc(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		have := []types.TIntStr{{Int: 1}, {Int: 1}, {Int: 2}}
+		compare := func(a, b types.TIntStr) int { return a.Int - b.Int }
+
+		// --- When ---
+		err := SortedFunc(have, compare)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		have := []string{"abc", "a", "ab"}
+		compare := func(a, b string) int { return len(a) - len(b) }
+
+		// --- When ---
+		err := SortedFunc(have, compare)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to be sorted:\n" +
+			"    [0]: \"abc\"\n" +
+			"    [1]: \"a\"\n" +
+			"  slice:\n" +
+			"         []string{\n" +
+			"           \"abc\",\n" +
+			"           \"a\",\n" +
+			"           \"ab\",\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		compare := func(a, b int) int { return a - b }
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := SortedFunc([]int{2, 1}, compare, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to be sorted:\n" +
+			"  trail: type.field\n" +
+			"    [0]: 2\n" +
+			"    [1]: 1\n" +
+			"  slice:\n" +
+			"         []int{\n" +
+			"           2,\n" +
+			"           1,\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_StrictlyIncreasing(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := StrictlyIncreasing([]float64{1.5, 2, 3})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - equal values", func(t *testing.T) {
+		// --- When ---
+		err := StrictlyIncreasing([]int{1, 2, 2})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to be strictly increasing:\n" +
+			"    [1]: 2\n" +
+			"    [2]: 2\n" +
+			"  slice:\n" +
+			"         []int{\n" +
+			"           1,\n" +
+			"           2,\n" +
+			"           2,\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := StrictlyIncreasing([]int{2, 1}, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to be strictly increasing:\n" +
+			"  trail: type.field\n" +
+			"    [0]: 2\n" +
+			"    [1]: 1\n" +
+			"  slice:\n" +
+			"         []int{\n" +
+			"           2,\n" +
+			"           1,\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Decreasing(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := Decreasing([]int{3, 2, 2, 1})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := Decreasing([]int{3, 2, 4})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to be decreasing:\n" +
+			"    [1]: 2\n" +
+			"    [2]: 4\n" +
+			"  slice:\n" +
+			"         []int{\n" +
+			"           3,\n" +
+			"           2,\n" +
+			"           4,\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Decreasing([]int{1, 2}, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice to be decreasing:\n" +
+			"  trail: type.field\n" +
+			"    [0]: 1\n" +
+			"    [1]: 2\n" +
+			"  slice:\n" +
+			"         []int{\n" +
+			"           1,\n" +
+			"           2,\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Each(t *testing.T) {
+	t.Run("success - slice", func(t *testing.T) {
+		// --- When ---
+		err := Each([]int{1, 2, 3}, NotZero)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - empty", func(t *testing.T) {
+		// --- When ---
+		err := Each([]int{}, NotZero)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - typed check", func(t *testing.T) {
+		// --- Given ---
+		have := []types.TIntStr{{Int: 1}, {Int: 2}}
+		chk := func(have types.TIntStr, opts ...Option) error {
+			return Positive(have.Int, opts...)
+		}
+
+		// --- When ---
+		err := Each(have, chk)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - slice", func(t *testing.T) {
+		// --- When ---
+		err := Each([]int{1, 0, 3, 0}, NotZero)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected argument not to be zero value:\n" +
+			"  trail: <slice>[1]\n" +
+			"   want: <non-zero>\n" +
+			"   have: 0\n" +
+			" ---\n" +
+			"  trail: <slice>[3]\n" +
+			"   want: <non-zero>\n" +
+			"   have: 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - array", func(t *testing.T) {
+		// --- When ---
+		err := Each([2]int{1, 0}, NotZero)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected argument not to be zero value:\n" +
+			"  trail: <array>[1]\n" +
+			"   want: <non-zero>\n" +
+			"   have: 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - map values in key order", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"B": 0, "A": 0, "C": 1}
+
+		// --- When ---
+		err := Each(have, NotZero)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected argument not to be zero value:\n" +
+			"  trail: map[\"A\"]\n" +
+			"   want: <non-zero>\n" +
+			"   have: 0\n" +
+			" ---\n" +
+			"  trail: map[\"B\"]\n" +
+			"   want: <non-zero>\n" +
+			"   have: 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - nested trail", func(t *testing.T) {
+		// --- Given ---
+		have := []types.TIntStr{{Int: 1}, {Int: 2, Str: "abc"}}
+		want := types.TIntStr{Int: 2, Str: "abc"}
+		chk := func(have types.TIntStr, opts ...Option) error {
+			return Equal(want, have, opts...)
+		}
+
+		// --- When ---
+		err := Each(have, chk, WithTrail("[]TIntStr"))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected values to be equal:\n" +
+			"  trail: []TIntStr[0].Int\n" +
+			"   want: 2\n" +
+			"   have: 1\n" +
+			" ---\n" +
+			"  trail: []TIntStr[0].Str\n" +
+			"   want: \"abc\"\n" +
+			"   have: \"\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not a collection", func(t *testing.T) {
+		// --- When ---
+		err := Each(42, NotZero)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice, array or map:\n" +
+			"  got type: int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - element of invalid type", func(t *testing.T) {
+		// --- Given ---
+		chk := func(have int, opts ...Option) error { return nil }
+
+		// --- When ---
+		err := Each([]any{1, "a"}, chk)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected elements of type int:\n" +
+			"     trail: <slice>[1]\n" +
+			"  got type: string"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Each([]int{1, 0}, NotZero, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected argument not to be zero value:\n" +
+			"  trail: type.field[1]\n" +
+			"   want: <non-zero>\n" +
+			"   have: 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_AnyMatch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return have > 2 }
+
+		// --- When ---
+		err := AnyMatch([]int{1, 2, 3}, pred)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - map", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have string) bool { return have == "b" }
+
+		// --- When ---
+		err := AnyMatch(map[int]string{1: "a", 2: "b"}, pred)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return have > 2 }
+
+		// --- When ---
+		err := AnyMatch([]int{1, 2}, pred)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected at least one element to match predicate:\n" +
+			"  have:\n" +
+			"        []int{\n" +
+			"          1,\n" +
+			"          2,\n" +
+			"        }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - empty", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return true }
+
+		// --- When ---
+		err := AnyMatch([]int{}, pred)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected at least one element to match predicate:\n" +
+			"  have: []int{}"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not a collection", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return true }
+
+		// --- When ---
+		err := AnyMatch(42, pred)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slice, array or map:\n" +
+			"  got type: int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return false }
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := AnyMatch([]int{1}, pred, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected at least one element to match predicate:\n" +
+			"  trail: type.field\n" +
+			"   have:\n" +
+			"         []int{\n" +
+			"           1,\n" +
+			"         }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_NoneMatch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return have > 3 }
+
+		// --- When ---
+		err := NoneMatch([]int{1, 2, 3}, pred)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return have > 1 }
+
+		// --- When ---
+		err := NoneMatch([]int{1, 2, 3}, pred)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no element to match predicate:\n" +
+			"  trail: <slice>[1]\n" +
+			"   have: 2\n" +
+			" ---\n" +
+			"  trail: <slice>[2]\n" +
+			"   have: 3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - map", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have string) bool { return have == "b" }
+
+		// --- When ---
+		err := NoneMatch(map[int]string{1: "a", 2: "b"}, pred)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no element to match predicate:\n" +
+			"  trail: map[2]\n" +
+			"   have: \"b\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - map numeric keys in order", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have string) bool { return true }
+
+		// --- When ---
+		err := NoneMatch(map[int]string{10: "b", 9: "a"}, pred)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no element to match predicate:\n" +
+			"  trail: map[9]\n" +
+			"   have: \"a\"\n" +
+			" ---\n" +
+			"  trail: map[10]\n" +
+			"   have: \"b\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - map with interface keys in order", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have string) bool { return true }
+		have := map[any]string{"b": "d", 10: "b", 9: "a", true: "c"}
+
+		// --- When ---
+		err := NoneMatch(have, pred)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no element to match predicate:\n" +
+			"  trail: map[true]\n" +
+			"   have: \"c\"\n" +
+			" ---\n" +
+			"  trail: map[9]\n" +
+			"   have: \"a\"\n" +
+			" ---\n" +
+			"  trail: map[10]\n" +
+			"   have: \"b\"\n" +
+			" ---\n" +
+			"  trail: map[\"b\"]\n" +
+			"   have: \"d\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return true }
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := NoneMatch([]int{1}, pred, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no element to match predicate:\n" +
+			"  trail: type.field[0]\n" +
+			"   have: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_CountFunc(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return have > 1 }
+
+		// --- When ---
+		err := CountFunc(2, []int{1, 2, 3}, pred)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - zero", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return have > 5 }
+
+		// --- When ---
+		err := CountFunc(0, []int{1, 2, 3}, pred)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return have > 1 }
+
+		// --- When ---
+		err := CountFunc(1, []int{1, 2, 3}, pred)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected number of elements matching predicate:\n" +
+			"      want: 1\n" +
+			"      have: 2\n" +
+			"  matching:\n" +
+			"            <slice>[1]\n" +
+			"            <slice>[2]"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - none matching", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return have > 5 }
+
+		// --- When ---
+		err := CountFunc(1, map[string]int{"A": 1}, pred)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected number of elements matching predicate:\n" +
+			"  want: 1\n" +
+			"  have: 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		pred := func(have int) bool { return true }
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := CountFunc(2, []int{1}, pred, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected number of elements matching predicate:\n" +
+			"     trail: type.field\n" +
+			"      want: 2\n" +
+			"      have: 1\n" +
+			"  matching: type.field[0]"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_MapKeys(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"A": 1, "B": 2}
+
+		// --- When ---
+		err := MapKeys([]string{"B", "A"}, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - duplicate want keys", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"A": 1}
+
+		// --- When ---
+		err := MapKeys([]string{"A", "A"}, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - both empty", func(t *testing.T) {
+		// --- When ---
+		err := MapKeys([]int{}, map[int]int(nil))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - missing keys", func(t *testing.T) {
+		// --- Given ---
+		have := map[int]int{2: 2}
+
+		// --- When ---
+		err := MapKeys([]int{10, 2, 3}, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected map to have keys:\n" +
+			"  missing key(s): 3, 10"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - missing and unexpected keys", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"A": 1, "D": 4, "C": 3}
+
+		// --- When ---
+		err := MapKeys([]string{"B", "A", "E"}, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected map to have keys:\n" +
+			"     missing key(s): \"B\", \"E\"\n" +
+			"  unexpected key(s): \"C\", \"D\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - mixed kind keys", func(t *testing.T) {
+		// --- Given ---
+		have := map[any]int{1: 1, "b": 2, 3.5: 5, true: 6}
+
+		// --- When ---
+		err := MapKeys([]any{1, "b", "c"}, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected map to have keys:\n" +
+			"     missing key(s): \"c\"\n" +
+			"  unexpected key(s): true, 3.5"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"A": 1, "B": 2}
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := MapKeys([]string{"A"}, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected map to have keys:\n" +
+			"              trail: type.field\n" +
+			"  unexpected key(s): \"B\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_MapHasKeys(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"A": 1, "B": 2, "C": 3}
+
+		// --- When ---
+		err := MapHasKeys([]string{"C", "A"}, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - no keys", func(t *testing.T) {
+		// --- When ---
+		err := MapHasKeys(nil, map[string]int{"A": 1})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		have := map[int]int{1: 1}
+
+		// --- When ---
+		err := MapHasKeys([]int{20, 1, 3, 3}, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" map to have key(s):\n" +
+			"  missing key(s): 3, 20"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - mixed kind keys", func(t *testing.T) {
+		// --- Given ---
+		have := map[any]int{1: 1}
+
+		// --- When ---
+		err := MapHasKeys([]any{"b", 1, 3.5, true}, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" map to have key(s):\n" +
+			"  missing key(s): true, 3.5, \"b\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := MapHasKeys([]string{"A"}, map[string]int{}, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" map to have key(s):\n" +
+			"           trail: type.field\n" +
+			"  missing key(s): \"A\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_MapValuesMatch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"A": 1, "B": 2, "C": 1}
+
+		// --- When ---
+		err := MapValuesMatch([]int{1, 2, 1}, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"A": 1, "B": 2, "C": 3}
+
+		// --- When ---
+		err := MapValuesMatch([]int{1, 2, 4}, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected map to have the same values:\n" +
+			"     missing:\n" +
+			"              []int{\n" +
+			"                4,\n" +
+			"              }\n" +
+			"  unexpected:\n" +
+			"              []int{\n" +
+			"                3,\n" +
+			"              }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - mixed kind keys", func(t *testing.T) {
+		// --- Given ---
+		have := map[any]int{1: 1, "b": 2, 3.5: 5, true: 6}
+
+		// --- When ---
+		err := MapValuesMatch([]int{1, 2, 5, 7}, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected map to have the same values:\n" +
+			"     missing:\n" +
+			"              []int{\n" +
+			"                7,\n" +
+			"              }\n" +
+			"  unexpected:\n" +
+			"              []int{\n" +
+			"                6,\n" +
+			"              }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"A": 1}
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := MapValuesMatch([]int{1, 2}, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected map to have the same values:\n" +
+			"    trail: type.field\n" +
+			"  missing:\n" +
+			"           []int{\n" +
+			"             2,\n" +
+			"           }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_MapEach(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"A": 1, "B": 2}
+		fn := func(k string, v int) error { return Greater(0, v) }
+
+		// --- When ---
+		err := MapEach(have, fn)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - nil map", func(t *testing.T) {
+		// --- Given ---
+		fn := func(k string, v int) error { return errors.New("never") }
+
+		// --- When ---
+		err := MapEach(map[string]int(nil), fn)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - notice", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"C": 3, "A": 1, "B": 2}
+		fn := func(k string, v int) error { return Greater(1, v) }
+
+		// --- When ---
+		err := MapEach(have, fn)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be greater than \"want\":\n" +
+			"  trail: map[\"A\"]\n" +
+			"   want: 1\n" +
+			"   have: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - notice with trail", func(t *testing.T) {
+		// --- Given ---
+		have := map[int]int{10: 1, 2: 2}
+		fn := func(k int, v int) error {
+			return Equal(0, v, WithTrail("value"))
+		}
+
+		// --- When ---
+		err := MapEach(have, fn)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected values to be equal:\n" +
+			"  trail: value\n" +
+			"   want: 0\n" +
+			"   have: 2\n" +
+			" ---\n" +
+			"  trail: value\n" +
+			"   want: 0\n" +
+			"   have: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not notice", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"A": 1}
+		fn := func(k string, v int) error { return errors.New("bad") }
+
+		// --- When ---
+		err := MapEach(have, fn)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected map entry to pass the check:\n" +
+			"  trail: map[\"A\"]\n" +
+			"  error: bad"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - mixed kind keys", func(t *testing.T) {
+		// --- Given ---
+		have := map[any]int{1: 1, "b": 2, 3.5: 5, true: 6}
+		fn := func(k any, v int) error { return Greater(10, v) }
+
+		// --- When ---
+		err := MapEach(have, fn)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be greater than \"want\":\n" +
+			"  trail: map[true]\n" +
+			"   want: 10\n" +
+			"   have: 6\n" +
+			" ---\n" +
+			"  trail: map[1]\n" +
+			"   want: 10\n" +
+			"   have: 1\n" +
+			" ---\n" +
+			"  trail: map[3.5]\n" +
+			"   want: 10\n" +
+			"   have: 5\n" +
+			" ---\n" +
+			"  trail: map[\"b\"]\n" +
+			"   want: 10\n" +
+			"   have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"A": 1}
+		fn := func(k string, v int) error { return errors.New("bad") }
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := MapEach(have, fn, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected map entry to pass the check:\n" +
+			"  trail: type.field[\"A\"]\n" +
+			"  error: bad"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
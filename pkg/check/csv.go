@@ -0,0 +1,295 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"encoding/csv"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// CSV checks that two CSV documents with a header row are equivalent. Cells
+// are compared by column name, so with [WithUnorderedColumns] option the
+// order of columns does not matter. Use [WithUnorderedRows] option to compare
+// rows regardless of their order or [WithKeyColumns] option to match rows by
+// the values of the key columns.
+//
+// Custom checkers set with [WithTrailChecker] and trails set with
+// [WithSkipTrail] are matched against the cell trail like "row[12].price" or
+// the column trail like "row[*].price". Checkers are called with "want" and
+// "have" cell values as strings.
+//
+// Returns nil if documents are equivalent, otherwise it returns an error with
+// a message for each difference.
+//
+// Example:
+//
+//	check.CSV("id,price\n1,9.99\n", "price,id\n9.99,1\n", check.WithUnorderedColumns)
+func CSV(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	wRecs, err := csv.NewReader(strings.NewReader(want)).ReadAll()
+	if err != nil {
+		return notice.New("did not expect unmarshalling error").
+			Trail(ops.Trail).
+			Append("argument", "want").
+			Append("error", "%s", err)
+	}
+	hRecs, err := csv.NewReader(strings.NewReader(have)).ReadAll()
+	if err != nil {
+		return notice.New("did not expect unmarshalling error").
+			Trail(ops.Trail).
+			Append("argument", "have").
+			Append("error", "%s", err)
+	}
+	wTab, hTab := newCSVTable(wRecs), newCSVTable(hRecs)
+	if err = csvHeaderEqual(wTab.header, hTab.header, ops); err != nil {
+		return err
+	}
+	for _, col := range ops.KeyColumns {
+		if !slices.Contains(wTab.header, col) {
+			return notice.New("expected CSV header to have key column").
+				Trail(ops.Trail).
+				Append("column", "%s", col).
+				Append("header", "%s", csvLine(wTab.header))
+		}
+	}
+
+	var pairs [][2]int
+	var missing, extra []int
+	switch {
+	case len(ops.KeyColumns) > 0:
+		pairs, missing, extra = wTab.matchKeys(hTab, ops.KeyColumns)
+	case ops.UnorderedRows:
+		pairs, missing, extra = wTab.matchRows(hTab, ops)
+	default:
+		pairs, missing, extra = wTab.matchOrder(hTab)
+	}
+
+	var ers []error
+	for _, pair := range pairs {
+		ers = append(ers, wTab.rowEqual(pair[0], hTab, pair[1], ops)...)
+	}
+	for _, i := range missing {
+		msg := notice.New("expected CSV row").
+			Trail(csvRowTrail(ops.Trail, strconv.Itoa(i))).
+			Want("%s", csvLine(wTab.rows[i]))
+		ers = append(ers, msg)
+	}
+	for _, j := range extra {
+		msg := notice.New("unexpected CSV row").
+			Trail(csvRowTrail(ops.Trail, strconv.Itoa(j))).
+			Have("%s", csvLine(hTab.rows[j]))
+		ers = append(ers, msg)
+	}
+	return wrap(errors.Join(ers...))
+}
+
+// csvHeaderEqual compares CSV headers. Returns error if they are not equal.
+func csvHeaderEqual(want, have []string, ops Options) error {
+	if slices.Equal(want, have) {
+		return nil
+	}
+	var missing, extra []string
+	for _, col := range want {
+		if !slices.Contains(have, col) {
+			missing = append(missing, col)
+		}
+	}
+	for _, col := range have {
+		if !slices.Contains(want, col) {
+			extra = append(extra, col)
+		}
+	}
+	if ops.UnorderedColumns && len(missing) == 0 && len(extra) == 0 {
+		return nil
+	}
+	msg := notice.New("expected CSV headers to be equal").
+		Trail(ops.Trail).
+		Want("%s", csvLine(want)).
+		Have("%s", csvLine(have))
+	if len(missing) > 0 {
+		_ = msg.Append("missing", "%s", strings.Join(missing, ", "))
+	}
+	if len(extra) > 0 {
+		_ = msg.Append("unexpected", "%s", strings.Join(extra, ", "))
+	}
+	return msg
+}
+
+// csvTable represents CSV document with a header row.
+type csvTable struct {
+	header []string       // Column names.
+	index  map[string]int // Column indexes by name.
+	rows   [][]string     // Data rows.
+}
+
+// newCSVTable returns new instance of csvTable. The first record is used as
+// a header.
+func newCSVTable(recs [][]string) csvTable {
+	tab := csvTable{index: make(map[string]int)}
+	if len(recs) == 0 {
+		return tab
+	}
+	tab.header, tab.rows = recs[0], recs[1:]
+	for i, col := range tab.header {
+		tab.index[col] = i
+	}
+	return tab
+}
+
+// cell returns the value in the row "i" and column "col".
+func (tab csvTable) cell(i int, col string) string {
+	return tab.rows[i][tab.index[col]]
+}
+
+// key returns values of the key columns in row "i".
+func (tab csvTable) key(i int, cols []string) string {
+	vals := make([]string, 0, len(cols))
+	for _, col := range cols {
+		vals = append(vals, tab.cell(i, col))
+	}
+	return csvLine(vals)
+}
+
+// matchOrder pairs rows by their position. Returns pairs of want and have
+// row indexes, indexes of missing want rows and unexpected have rows.
+func (tab csvTable) matchOrder(have csvTable) ([][2]int, []int, []int) {
+	var pairs [][2]int
+	var missing, extra []int
+	for i := range max(len(tab.rows), len(have.rows)) {
+		switch {
+		case i >= len(have.rows):
+			missing = append(missing, i)
+		case i >= len(tab.rows):
+			extra = append(extra, i)
+		default:
+			pairs = append(pairs, [2]int{i, i})
+		}
+	}
+	return pairs, missing, extra
+}
+
+// matchKeys pairs rows with the same key columns values. Returns pairs of
+// want and have row indexes, indexes of missing want rows and unexpected have
+// rows.
+func (tab csvTable) matchKeys(
+	have csvTable,
+	cols []string,
+) ([][2]int, []int, []int) {
+
+	// For each key, queue of have row indexes.
+	keys := make(map[string][]int, len(have.rows))
+	for j := range have.rows {
+		key := have.key(j, cols)
+		keys[key] = append(keys[key], j)
+	}
+	var pairs [][2]int
+	var missing []int
+	used := make([]bool, len(have.rows))
+	for i := range tab.rows {
+		key := tab.key(i, cols)
+		if len(keys[key]) == 0 {
+			missing = append(missing, i)
+			continue
+		}
+		j := keys[key][0]
+		keys[key] = keys[key][1:]
+		used[j] = true
+		pairs = append(pairs, [2]int{i, j})
+	}
+	return pairs, missing, csvUnused(used)
+}
+
+// matchRows pairs equal rows regardless of their order. Returns pairs of want
+// and have row indexes, indexes of missing want rows and unexpected have rows.
+func (tab csvTable) matchRows(
+	have csvTable,
+	ops Options,
+) ([][2]int, []int, []int) {
+
+	var pairs [][2]int
+	var missing []int
+	used := make([]bool, len(have.rows))
+	for i := range tab.rows {
+		found := false
+		for j := range have.rows {
+			if !used[j] && len(tab.rowEqual(i, have, j, ops)) == 0 {
+				used[j] = true
+				found = true
+				pairs = append(pairs, [2]int{i, j})
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, i)
+		}
+	}
+	return pairs, missing, csvUnused(used)
+}
+
+// rowEqual compares row "i" with row "j" of the "have" table column by
+// column. Returns error for each differing cell with trail of the "want" row.
+func (tab csvTable) rowEqual(i int, have csvTable, j int, ops Options) []error {
+	var ers []error
+	for _, col := range tab.header {
+		cOps := ops
+		cOps.Trail = csvRowTrail(ops.Trail, strconv.Itoa(i)) + "." + col
+		colTrail := csvRowTrail(ops.Trail, "*") + "." + col
+		if slices.Contains(ops.SkipTrails, cOps.Trail) ||
+			slices.Contains(ops.SkipTrails, colTrail) {
+			continue
+		}
+		wVal, hVal := tab.cell(i, col), have.cell(j, col)
+		chk, ok := ops.TrailCheckers[cOps.Trail]
+		if !ok {
+			chk, ok = ops.TrailCheckers[colTrail]
+		}
+		if ok {
+			if err := chk(wVal, hVal, WithOptions(cOps)); err != nil {
+				ers = append(ers, notice.Unwrap(err)...)
+			}
+			continue
+		}
+		if wVal != hVal {
+			msg := notice.New("expected CSV values to be equal").
+				Trail(cOps.Trail).
+				Want("%q", wVal).
+				Have("%q", hVal)
+			ers = append(ers, msg)
+		}
+	}
+	return ers
+}
+
+// csvRowTrail returns trail for the CSV row.
+func csvRowTrail(trail, idx string) string {
+	if trail != "" {
+		trail += "."
+	}
+	return trail + "row[" + idx + "]"
+}
+
+// csvUnused returns indexes of not used rows.
+func csvUnused(used []bool) []int {
+	var idx []int
+	for j, ok := range used {
+		if !ok {
+			idx = append(idx, j)
+		}
+	}
+	return idx
+}
+
+// csvLine returns CSV encoded record without the trailing new line.
+func csvLine(rec []string) string {
+	buf := &strings.Builder{}
+	w := csv.NewWriter(buf)
+	_ = w.Write(rec)
+	w.Flush()
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -0,0 +1,306 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_CSV(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := "id,name\n1,a\n2,b\n"
+		have := "id,name\n1,a\n2,b"
+
+		// --- When ---
+		err := CSV(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal - empty", func(t *testing.T) {
+		// --- When ---
+		err := CSV("", "")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal - unordered columns", func(t *testing.T) {
+		// --- Given ---
+		want := "id,name\n1,a\n"
+		have := "name,id\na,1\n"
+
+		// --- When ---
+		err := CSV(want, have, WithUnorderedColumns)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal - unordered rows", func(t *testing.T) {
+		// --- Given ---
+		want := "id,name\n1,a\n2,b\n1,a\n"
+		have := "id,name\n1,a\n1,a\n2,b\n"
+
+		// --- When ---
+		err := CSV(want, have, WithUnorderedRows)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal - key columns", func(t *testing.T) {
+		// --- Given ---
+		want := "id,name\n1,a\n2,b\n"
+		have := "id,name\n2,b\n1,a\n"
+
+		// --- When ---
+		err := CSV(want, have, WithKeyColumns("id"))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal - column checker", func(t *testing.T) {
+		// --- Given ---
+		want := "id,at\n1,2025-01-01\n2,2025-01-02\n"
+		have := "id,at\n1,2025-02-01\n2,2025-02-02\n"
+		chk := func(want, have any, opts ...Option) error { return nil }
+
+		// --- When ---
+		err := CSV(want, have, WithTrailChecker("row[*].at", chk))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal - skip column", func(t *testing.T) {
+		// --- Given ---
+		want := "id,at\n1,2025-01-01\n"
+		have := "id,at\n1,2025-02-01\n"
+
+		// --- When ---
+		err := CSV(want, have, WithSkipTrail("row[*].at"))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal - cell checker", func(t *testing.T) {
+		// --- Given ---
+		want := "id,price\n1,1.0\n2,2.0\n"
+		have := "id,price\n1,1.00\n2,2.00\n"
+		chk := func(want, have any, opts ...Option) error {
+			ops := DefaultOptions(opts...)
+			return Equal("2.0", have, WithTrail(ops.Trail))
+		}
+
+		// --- When ---
+		err := CSV(want, have, WithTrailChecker("row[1].price", chk))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected CSV values to be equal:\n" +
+			"  trail: row[0].price\n" +
+			"   want: \"1.0\"\n" +
+			"   have: \"1.00\"\n" +
+			"\n" +
+			"expected values to be equal:\n" +
+			"  trail: row[1].price\n" +
+			"   want: \"2.0\"\n" +
+			"   have: \"2.00\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - values", func(t *testing.T) {
+		// --- Given ---
+		want := "id,name,price\n1,a,1.0\n2,b,2.0\n"
+		have := "id,name,price\n1,a,1.5\n2,c,2.0\n"
+
+		// --- When ---
+		err := CSV(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected CSV values to be equal:\n" +
+			"  trail: row[0].price\n" +
+			"   want: \"1.0\"\n" +
+			"   have: \"1.5\"\n" +
+			" ---\n" +
+			"  trail: row[1].name\n" +
+			"   want: \"b\"\n" +
+			"   have: \"c\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - row count", func(t *testing.T) {
+		// --- Given ---
+		want := "id,name\n1,a\n2,b\n"
+		have := "id,name\n1,a\n"
+
+		// --- When ---
+		err := CSV(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected CSV row:\n" +
+			"  trail: row[1]\n" +
+			"   want: 2,b"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - unexpected row", func(t *testing.T) {
+		// --- Given ---
+		want := "id,name\n1,a\n"
+		have := "id,name\n1,a\n2,\"b,c\"\n"
+
+		// --- When ---
+		err := CSV(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "unexpected CSV row:\n" +
+			"  trail: row[1]\n" +
+			"   have: 2,\"b,c\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - unordered rows", func(t *testing.T) {
+		// --- Given ---
+		want := "id,name\n1,a\n2,b\n"
+		have := "id,name\n3,c\n1,a\n"
+
+		// --- When ---
+		err := CSV(want, have, WithUnorderedRows)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected CSV row:\n" +
+			"  trail: row[1]\n" +
+			"   want: 2,b\n" +
+			"\n" +
+			"unexpected CSV row:\n" +
+			"  trail: row[0]\n" +
+			"   have: 3,c"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - key columns", func(t *testing.T) {
+		// --- Given ---
+		want := "id,name,price\n1,a,1.0\n2,b,2.0\n3,c,3.0\n"
+		have := "id,name,price\n4,d,4.0\n2,b,2.5\n1,a,1.0\n"
+
+		// --- When ---
+		err := CSV(want, have, WithKeyColumns("id", "name"))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected CSV values to be equal:\n" +
+			"  trail: row[1].price\n" +
+			"   want: \"2.0\"\n" +
+			"   have: \"2.5\"\n" +
+			"\n" +
+			"expected CSV row:\n" +
+			"  trail: row[2]\n" +
+			"   want: 3,c,3.0\n" +
+			"\n" +
+			"unexpected CSV row:\n" +
+			"  trail: row[0]\n" +
+			"   have: 4,d,4.0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - header", func(t *testing.T) {
+		// --- Given ---
+		want := "id,name,price\n"
+		have := "id,price,qty\n"
+
+		// --- When ---
+		err := CSV(want, have, WithUnorderedColumns)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected CSV headers to be equal:\n" +
+			"        want: id,name,price\n" +
+			"        have: id,price,qty\n" +
+			"     missing: name\n" +
+			"  unexpected: qty"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - header order", func(t *testing.T) {
+		// --- When ---
+		err := CSV("id,name\n", "name,id\n")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected CSV headers to be equal:\n" +
+			"  want: id,name\n" +
+			"  have: name,id"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - key column does not exist", func(t *testing.T) {
+		// --- When ---
+		err := CSV("id,name\n", "id,name\n", WithKeyColumns("key"))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected CSV header to have key column:\n" +
+			"  column: key\n" +
+			"  header: id,name"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("invalid want CSV", func(t *testing.T) {
+		// --- When ---
+		err := CSV("id,name\n1\n", "id,name\n")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect unmarshalling error:\n" +
+			"  argument: want\n" +
+			"     error: record on line 2: wrong number of fields"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("invalid have CSV", func(t *testing.T) {
+		// --- When ---
+		err := CSV("id,name\n", "id,\"name\n")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect unmarshalling error:\n" +
+			"  argument: have\n" +
+			"     error: parse error on line 1, column 10: " +
+			"extraneous or missing \" in quoted-field"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		want := "id,name\n1,a\n"
+		have := "id,name\n2,b\n"
+		chk := func(want, have any, opts ...Option) error { return nil }
+		opts := []Option{
+			WithTrail("type.field"),
+			WithTrailChecker("type.field.row[*].id", chk),
+		}
+
+		// --- When ---
+		err := CSV(want, have, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected CSV values to be equal:\n" +
+			"  trail: type.field.row[0].name\n" +
+			"   want: \"a\"\n" +
+			"   have: \"b\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
@@ -0,0 +1,335 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// DirEqual checks directory tree at "haveDir" is equal to the golden
+// directory tree at "wantDir". Files missing from "haveDir", unexpected files
+// and files with different content are reported. For text files the first
+// differing line is reported. Use [WithIgnoreGlobs] option to ignore paths
+// matching [path.Match] patterns (matched against the slash separated path
+// relative to the directory and its base name), [WithCompareMode] option to
+// compare file modes and permissions and [WithUpdateDir] option to update the
+// golden directory to match the "haveDir" tree. Paths matching ignore globs
+// are never removed from the golden directory. Returns nil if trees are
+// equal, otherwise it returns an error with a message for each difference.
+func DirEqual(wantDir, haveDir string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	if ops.UpdateDir {
+		return dirUpdate(wantDir, haveDir, ops)
+	}
+	wEnts, err := dirEntries(wantDir, ops)
+	if err != nil {
+		return err
+	}
+	hEnts, err := dirEntries(haveDir, ops)
+	if err != nil {
+		return err
+	}
+
+	var ers []error
+	for _, rel := range dirPaths(wEnts, hEnts) {
+		wInf, wOK := wEnts[rel]
+		hInf, hOK := hEnts[rel]
+		switch {
+		case !hOK:
+			msg := notice.New("expected %s to exist", dirKind(wInf)).
+				Trail(ops.Trail).
+				Append("path", "%s", rel)
+			ers = append(ers, msg)
+
+		case !wOK:
+			msg := notice.New("unexpected %s", dirKind(hInf)).
+				Trail(ops.Trail).
+				Append("path", "%s", rel)
+			ers = append(ers, msg)
+
+		default:
+			wPth := filepath.Join(wantDir, filepath.FromSlash(rel))
+			hPth := filepath.Join(haveDir, filepath.FromSlash(rel))
+			if err = dirEntryEqual(wPth, hPth, wInf, hInf, rel, ops); err != nil {
+				ers = append(ers, err)
+			}
+		}
+	}
+	return wrap(errors.Join(ers...))
+}
+
+// dirEntryEqual compares directory entries with the same relative path.
+func dirEntryEqual(
+	wPth, hPth string,
+	wInf, hInf fs.FileInfo,
+	rel string,
+	ops Options,
+) error {
+
+	if wKind, hKind := dirKind(wInf), dirKind(hInf); wKind != hKind {
+		return notice.New("expected directory entries of the same kind").
+			Trail(ops.Trail).
+			Append("path", "%s", rel).
+			Want("%s", wKind).
+			Have("%s", hKind)
+	}
+	if ops.CompareMode && wInf.Mode() != hInf.Mode() {
+		return notice.New("expected file modes to be equal").
+			Trail(ops.Trail).
+			Append("path", "%s", rel).
+			Want("%s", fileMode(wInf.Mode())).
+			Have("%s", fileMode(hInf.Mode()))
+	}
+
+	switch {
+	case wInf.IsDir():
+		return nil
+
+	case wInf.Mode()&fs.ModeSymlink != 0:
+		wTgt, wErr := os.Readlink(wPth)
+		hTgt, hErr := os.Readlink(hPth)
+		if err := errors.Join(wErr, hErr); err != nil {
+			return notice.New("expected no error reading symlink").
+				Trail(ops.Trail).
+				Append("path", "%s", rel).
+				Append("error", "%s", err)
+		}
+		if wTgt != hTgt {
+			return notice.New("expected symlink targets to be equal").
+				Trail(ops.Trail).
+				Append("path", "%s", rel).
+				Want("%s", wTgt).
+				Have("%s", hTgt)
+		}
+		return nil
+	}
+
+	want, wErr := os.ReadFile(wPth)
+	have, hErr := os.ReadFile(hPth)
+	if err := errors.Join(wErr, hErr); err != nil {
+		return notice.New("expected no error reading file").
+			Trail(ops.Trail).
+			Append("path", "%s", rel).
+			Append("error", "%s", err)
+	}
+	if bytes.Equal(want, have) {
+		return nil
+	}
+
+	msg := notice.New("expected files to be equal").
+		Trail(ops.Trail).
+		Append("path", "%s", rel)
+	return contentDiff(msg, want, have)
+}
+
+// dirEntries walks the directory tree and returns file information of all its
+// entries by slash separated path relative to the directory. Paths matching
+// [Options.IgnoreGlobs] are skipped.
+func dirEntries(dir string, ops Options) (map[string]fs.FileInfo, error) {
+	inf, err := os.Stat(dir)
+	if err == nil && !inf.IsDir() {
+		return nil, notice.New("expected path to be existing directory").
+			Trail(ops.Trail).
+			Append("path", "%s", dir)
+	}
+
+	ents := make(map[string]fs.FileInfo)
+	err = filepath.WalkDir(dir, func(pth string, ent fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if pth == dir {
+			return nil
+		}
+		rel, _ := filepath.Rel(dir, pth)
+		rel = filepath.ToSlash(rel)
+		if isIgnored(rel, ops.IgnoreGlobs) {
+			if ent.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		inf, err := ent.Info()
+		if err != nil {
+			return err
+		}
+		ents[rel] = inf
+		return nil
+	})
+	if err != nil {
+		return nil, notice.New("expected no error reading directory").
+			Trail(ops.Trail).
+			Append("path", "%s", dir).
+			Append("error", "%s", err)
+	}
+	return ents, nil
+}
+
+// dirUpdate updates the golden directory in place to match the "haveDir"
+// tree. Entries missing from "haveDir" are removed, other entries are created
+// or overwritten. Paths matching [Options.IgnoreGlobs] are neither copied nor
+// removed. Returns an error when directories are the same or nested.
+func dirUpdate(wantDir, haveDir string, ops Options) error {
+	if dirsOverlap(wantDir, haveDir) {
+		return notice.New("expected golden and actual directories not to overlap").
+			Trail(ops.Trail).
+			Append("want path", "%s", wantDir).
+			Append("have path", "%s", haveDir)
+	}
+	hEnts, err := dirEntries(haveDir, ops)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(wantDir, 0o755); err != nil {
+		return dirUpdateError(wantDir, err, ops)
+	}
+	wEnts, err := dirEntries(wantDir, ops)
+	if err != nil {
+		return err
+	}
+
+	// Remove obsolete entries, children before their parents.
+	pths := dirPaths(wEnts, nil)
+	for i := len(pths) - 1; i >= 0; i-- {
+		rel := pths[i]
+		wInf := wEnts[rel]
+		if hInf, ok := hEnts[rel]; ok && dirKind(wInf) == dirKind(hInf) {
+			continue
+		}
+		dst := filepath.Join(wantDir, filepath.FromSlash(rel))
+		if err = dirRemove(dst, wInf); err != nil {
+			return dirUpdateError(wantDir, err, ops)
+		}
+	}
+
+	for _, rel := range dirPaths(hEnts, nil) {
+		inf := hEnts[rel]
+		src := filepath.Join(haveDir, filepath.FromSlash(rel))
+		dst := filepath.Join(wantDir, filepath.FromSlash(rel))
+		if err = dirCopy(src, dst, inf); err != nil {
+			return dirUpdateError(wantDir, err, ops)
+		}
+	}
+	return nil
+}
+
+// dirUpdateError returns a notice about the golden directory update error.
+func dirUpdateError(wantDir string, err error, ops Options) error {
+	return notice.New("expected no error updating golden directory").
+		Trail(ops.Trail).
+		Append("path", "%s", wantDir).
+		Append("error", "%s", err)
+}
+
+// dirRemove removes the directory entry at "pth". Directories are removed
+// only when empty, so they keep entries matching [Options.IgnoreGlobs].
+func dirRemove(pth string, inf fs.FileInfo) error {
+	if !inf.IsDir() {
+		return os.Remove(pth)
+	}
+	ents, err := os.ReadDir(pth)
+	if err != nil || len(ents) > 0 {
+		return err
+	}
+	return os.Remove(pth)
+}
+
+// dirCopy copies the directory entry from "src" to "dst" overwriting the
+// existing file or symlink. Missing directories are created.
+func dirCopy(src, dst string, inf fs.FileInfo) error {
+	switch {
+	case inf.IsDir():
+		return os.MkdirAll(dst, inf.Mode().Perm())
+
+	case inf.Mode()&fs.ModeSymlink != 0:
+		tgt, err := os.Readlink(src)
+		if err != nil {
+			return err
+		}
+		if err = os.Remove(dst); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return os.Symlink(tgt, dst)
+
+	default:
+		data, err := os.ReadFile(src)
+		if err != nil {
+			return err
+		}
+		if err = os.WriteFile(dst, data, inf.Mode().Perm()); err != nil {
+			return err
+		}
+		return os.Chmod(dst, inf.Mode().Perm())
+	}
+}
+
+// dirsOverlap returns true when directories are the same or one of them is
+// nested in the other.
+func dirsOverlap(a, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return isWithin(absA, absB) || isWithin(absB, absA)
+}
+
+// isWithin returns true when "pth" is the same as "dir" or is nested in it.
+func isWithin(dir, pth string) bool {
+	rel, err := filepath.Rel(dir, pth)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// dirPaths returns sorted union of paths from both maps.
+func dirPaths(a, b map[string]fs.FileInfo) []string {
+	pths := make([]string, 0, len(a)+len(b))
+	for pth := range a {
+		pths = append(pths, pth)
+	}
+	for pth := range b {
+		if _, ok := a[pth]; !ok {
+			pths = append(pths, pth)
+		}
+	}
+	slices.Sort(pths)
+	return pths
+}
+
+// dirKind returns the kind of directory entry used in messages.
+func dirKind(inf fs.FileInfo) string {
+	switch {
+	case inf.IsDir():
+		return "directory"
+	case inf.Mode()&fs.ModeSymlink != 0:
+		return "symlink"
+	default:
+		return "file"
+	}
+}
+
+// isIgnored returns true if slash separated path or its base name matches
+// any of the patterns.
+func isIgnored(rel string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,409 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+// writeTree creates files with given content in the directory. File names
+// ending with a slash are created as directories.
+func writeTree(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		pth := filepath.Join(dir, filepath.FromSlash(name))
+		if name[len(name)-1] == '/' {
+			if err := os.MkdirAll(pth, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(pth), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(pth, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func Test_DirEqual(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		files := map[string]string{"a.txt": "A", "sub/b.txt": "B", "empty/": ""}
+		writeTree(t, want, files)
+		writeTree(t, have, files)
+
+		// --- When ---
+		err := DirEqual(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal - ignore globs", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		writeTree(t, want, map[string]string{"a.txt": "A"})
+		writeTree(t, have, map[string]string{
+			"a.txt":     "A",
+			"a.log":     "log",
+			"tmp/b.txt": "B",
+		})
+
+		// --- When ---
+		err := DirEqual(want, have, WithIgnoreGlobs("*.log", "tmp"))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal - modes not compared by default", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		writeTree(t, want, map[string]string{"a.sh": "A"})
+		writeTree(t, have, map[string]string{"a.sh": "A"})
+		affirm.Nil(t, os.Chmod(filepath.Join(have, "a.sh"), 0o750))
+
+		// --- When ---
+		err := DirEqual(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal - missing and unexpected", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		writeTree(t, want, map[string]string{"a.txt": "A", "b/": ""})
+		writeTree(t, have, map[string]string{"c.txt": "C"})
+
+		// --- When ---
+		err := DirEqual(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file to exist:\n" +
+			"  path: a.txt\n" +
+			"\n" +
+			"expected directory to exist:\n" +
+			"  path: b\n" +
+			"\n" +
+			"unexpected file:\n" +
+			"  path: c.txt"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - text content", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		writeTree(t, want, map[string]string{"a.txt": "line 1\nline 2\n"})
+		writeTree(t, have, map[string]string{"a.txt": "line 1\nline 3\n"})
+
+		// --- When ---
+		err := DirEqual(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected files to be equal:\n" +
+			"    path: a.txt\n" +
+			"    line: 2\n" +
+			"    want: \"line 2\"\n" +
+			"    have: \"line 3\"\n" +
+			"  offset: 5\n" +
+			"    diff:\n" +
+			"          \"line 2\"\n" +
+			"          \"line 3\"\n" +
+			"                ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - binary content", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		writeTree(t, want, map[string]string{"a.bin": "\x00\x01\x02"})
+		writeTree(t, have, map[string]string{"a.bin": "\x00\x01\x03\x04"})
+
+		// --- When ---
+		err := DirEqual(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected files to be equal:\n" +
+			"       path: a.bin\n" +
+			"  want size: 3\n" +
+			"  have size: 4\n" +
+			"     offset: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - entry kind", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		writeTree(t, want, map[string]string{"a/": ""})
+		writeTree(t, have, map[string]string{"a": "A"})
+
+		// --- When ---
+		err := DirEqual(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected directory entries of the same kind:\n" +
+			"  path: a\n" +
+			"  want: directory\n" +
+			"  have: file"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - symlink target", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		affirm.Nil(t, os.Symlink("a.txt", filepath.Join(want, "lnk")))
+		affirm.Nil(t, os.Symlink("b.txt", filepath.Join(have, "lnk")))
+
+		// --- When ---
+		err := DirEqual(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected symlink targets to be equal:\n" +
+			"  path: lnk\n" +
+			"  want: a.txt\n" +
+			"  have: b.txt"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - compare mode", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		writeTree(t, want, map[string]string{"a.sh": "A"})
+		writeTree(t, have, map[string]string{"a.sh": "A"})
+		affirm.Nil(t, os.Chmod(filepath.Join(want, "a.sh"), 0o750))
+		affirm.Nil(t, os.Chmod(filepath.Join(have, "a.sh"), 0o640))
+
+		// --- When ---
+		err := DirEqual(want, have, WithCompareMode)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file modes to be equal:\n" +
+			"  path: a.sh\n" +
+			"  want: 0750 -rwxr-x---\n" +
+			"  have: 0640 -rw-r-----"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("update", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		writeTree(t, want, map[string]string{"old.txt": "O"})
+		writeTree(t, have, map[string]string{
+			"a.txt":     "A",
+			"sub/b.txt": "B",
+			"c.log":     "C",
+		})
+		affirm.Nil(t, os.Chmod(filepath.Join(have, "a.txt"), 0o600))
+		opt := WithIgnoreGlobs("*.log")
+
+		// --- When ---
+		err := DirEqual(want, have, WithUpdateDir, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Nil(t, DirEqual(want, have, WithCompareMode, opt))
+		_, err = os.Stat(filepath.Join(want, "c.log"))
+		affirm.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("update - keeps ignored golden files", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		writeTree(t, want, map[string]string{
+			"README.md":     "golden readme",
+			"old.txt":       "O",
+			"sub/README.md": "sub readme",
+			"sub/old.txt":   "O",
+			"gone/old.txt":  "O",
+		})
+		writeTree(t, have, map[string]string{"a.txt": "A", "sub/b.txt": "B"})
+		opt := WithIgnoreGlobs("README.md")
+
+		// --- When ---
+		err := DirEqual(want, have, WithUpdateDir, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Nil(t, DirEqual(want, have, opt))
+		data, err := os.ReadFile(filepath.Join(want, "README.md"))
+		affirm.Nil(t, err)
+		affirm.Equal(t, "golden readme", string(data))
+		data, err = os.ReadFile(filepath.Join(want, "sub", "README.md"))
+		affirm.Nil(t, err)
+		affirm.Equal(t, "sub readme", string(data))
+		_, err = os.Stat(filepath.Join(want, "gone"))
+		affirm.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("update - overwrites existing entries", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		writeTree(t, want, map[string]string{"a.txt": "old", "b/c.txt": "C"})
+		writeTree(t, have, map[string]string{"a.txt": "new", "b": "B"})
+		affirm.Nil(t, os.Symlink("a.txt", filepath.Join(want, "l")))
+		affirm.Nil(t, os.Symlink("b", filepath.Join(have, "l")))
+
+		// --- When ---
+		err := DirEqual(want, have, WithUpdateDir)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Nil(t, DirEqual(want, have))
+	})
+
+	t.Run("no update with inline update option", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		writeTree(t, want, map[string]string{"a.txt": "old"})
+		writeTree(t, have, map[string]string{"a.txt": "new"})
+
+		// --- When ---
+		err := DirEqual(want, have, WithUpdateInline)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		data, err := os.ReadFile(filepath.Join(want, "a.txt"))
+		affirm.Nil(t, err)
+		affirm.Equal(t, "old", string(data))
+	})
+
+	t.Run("update - creates missing golden directory", func(t *testing.T) {
+		// --- Given ---
+		want := filepath.Join(t.TempDir(), "golden")
+		have := t.TempDir()
+		writeTree(t, have, map[string]string{"a.txt": "A"})
+
+		// --- When ---
+		err := DirEqual(want, have, WithUpdateDir)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Nil(t, DirEqual(want, have))
+	})
+
+	t.Run("error - update same directory", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		writeTree(t, dir, map[string]string{"a.txt": "A"})
+
+		// --- When ---
+		err := DirEqual(dir, dir+"/.", WithUpdateDir)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected golden and actual directories not to overlap:\n" +
+			"  want path: " + dir + "\n" +
+			"  have path: " + dir + "/."
+		affirm.Equal(t, wMsg, err.Error())
+		data, err := os.ReadFile(filepath.Join(dir, "a.txt"))
+		affirm.Nil(t, err)
+		affirm.Equal(t, "A", string(data))
+	})
+
+	t.Run("error - update have nested in want", func(t *testing.T) {
+		// --- Given ---
+		want := t.TempDir()
+		have := filepath.Join(want, "out")
+		writeTree(t, want, map[string]string{"g.txt": "G", "out/a.txt": "A"})
+
+		// --- When ---
+		err := DirEqual(want, have, WithUpdateDir)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected golden and actual directories not to overlap:\n" +
+			"  want path: " + want + "\n" +
+			"  have path: " + have
+		affirm.Equal(t, wMsg, err.Error())
+		_, err = os.Stat(filepath.Join(have, "a.txt"))
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - update want nested in have", func(t *testing.T) {
+		// --- Given ---
+		have := t.TempDir()
+		want := filepath.Join(have, "golden")
+		writeTree(t, have, map[string]string{"a.txt": "A", "golden/": ""})
+
+		// --- When ---
+		err := DirEqual(want, have, WithUpdateDir)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected golden and actual directories not to overlap:\n" +
+			"  want path: " + want + "\n" +
+			"  have path: " + have
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("update - sibling with common prefix", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		want, have := filepath.Join(dir, "out-golden"), filepath.Join(dir, "out")
+		writeTree(t, have, map[string]string{"a.txt": "A"})
+
+		// --- When ---
+		err := DirEqual(want, have, WithUpdateDir)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Nil(t, DirEqual(want, have))
+	})
+
+	t.Run("error - directory does not exist", func(t *testing.T) {
+		// --- Given ---
+		want := filepath.Join(t.TempDir(), "missing")
+
+		// --- When ---
+		err := DirEqual(want, t.TempDir())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error reading directory:\n" +
+			"   path: " + want + "\n" +
+			"  error: lstat " + want + ": no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not a directory", func(t *testing.T) {
+		// --- When ---
+		err := DirEqual(t.TempDir(), "testdata/file.txt")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to be existing directory:\n" +
+			"  path: testdata/file.txt"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		want, have := t.TempDir(), t.TempDir()
+		writeTree(t, want, map[string]string{"a.txt": "A"})
+
+		// --- When ---
+		err := DirEqual(want, have, WithTrail("type.field"))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file to exist:\n" +
+			"  trail: type.field\n" +
+			"   path: a.txt"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
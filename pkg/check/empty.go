@@ -24,7 +24,11 @@ import (
 //   - len(map) == 0
 //   - len(chan) == 0
 //   - time.Time{}
+//   - iterator yielding no items
 func Empty(have any, opts ...Option) error {
+	if core.IsSeq(have) {
+		return emptySeq(reflect.ValueOf(have), opts...)
+	}
 	if isEmpty(have) {
 		return nil
 	}
@@ -35,6 +39,21 @@ func Empty(have any, opts ...Option) error {
 		Have("%#v", have)
 }
 
+// emptySeq checks the iterator yields no items. The iterator is called once,
+// the notice renders the items collected by the check.
+func emptySeq(seq reflect.Value, opts ...Option) error {
+	keys, vals, more := core.Collect(seq, core.SeqLimit)
+	if len(vals) == 0 {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	replay := core.Replay(seq.Type(), keys, vals, more)
+	return notice.New("expected iterator to be empty").
+		Trail(ops.Trail).
+		Want("<empty>").
+		Have("%s", ops.Dumper.Value(replay))
+}
+
 // isEmpty returns true if "have" is empty.
 func isEmpty(have any) bool {
 	if core.IsNil(have) {
@@ -48,6 +67,12 @@ func isEmpty(have any) bool {
 			return true
 		}
 
+	case reflect.Func:
+		if core.SeqArity(val.Type()) > 0 {
+			_, vals, _ := core.Collect(val, 1)
+			return len(vals) == 0
+		}
+
 	case reflect.Ptr:
 		return isEmpty(val.Elem().Interface())
 
@@ -70,5 +95,8 @@ func NotEmpty(have any, opts ...Option) error {
 		return nil
 	}
 	ops := DefaultOptions(opts...)
+	if core.IsSeq(have) {
+		return notice.New("expected non-empty iterator").Trail(ops.Trail)
+	}
 	return notice.New("expected non-empty value").Trail(ops.Trail)
 }
@@ -4,10 +4,15 @@
 package check
 
 import (
+	"iter"
+	"maps"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
 	"github.com/ctx42/testing/internal/cases"
+	"github.com/ctx42/testing/pkg/dump"
 )
 
 func Test_Empty(t *testing.T) {
@@ -48,6 +53,69 @@ func Test_Empty(t *testing.T) {
 	})
 }
 
+func Test_Empty_iterator(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := Empty(slices.Values([]int{}))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - nil", func(t *testing.T) {
+		// --- Given ---
+		var seq iter.Seq[int]
+
+		// --- When ---
+		err := Empty(seq)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := Empty(slices.Values([]int{1}))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator to be empty:\n" +
+			"  want: <empty>\n" +
+			"  have:\n" +
+			"        iter.Seq[int]{\n" +
+			"          1,\n" +
+			"        }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - infinite", func(t *testing.T) {
+		// --- Given ---
+		seq := func(yield func(int) bool) {
+			for yield(0) {
+			}
+		}
+
+		// --- When ---
+		err := Empty(seq, WithDumper(dump.WithFlat))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.True(t, strings.HasSuffix(err.Error(), "0, <more>}"))
+	})
+
+	t.Run("error - one shot iterator", func(t *testing.T) {
+		// --- When ---
+		err := Empty(oneShotSeq(1, 2), WithDumper(dump.WithFlat))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator to be empty:\n" +
+			"  want: <empty>\n" +
+			"  have: iter.Seq[int]{1, 2}"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
 func Test_Empty_ZENValues(t *testing.T) {
 	for _, tc := range cases.ZENValues() {
 		t.Run("Empty "+tc.Desc, func(t *testing.T) {
@@ -100,3 +168,36 @@ func Test_NotEmpty(t *testing.T) {
 		affirm.Equal(t, wMsg, err.Error())
 	})
 }
+
+func Test_NotEmpty_iterator(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := NotEmpty(maps.All(map[string]int{"A": 1}))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := NotEmpty(slices.Values([]int{}))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, "expected non-empty iterator", err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := NotEmpty(slices.Values([]int{}), opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected non-empty iterator:\n" +
+			"  trail: type.field"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
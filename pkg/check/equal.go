@@ -11,13 +11,15 @@ import (
 	"sort"
 	"time"
 
+	"github.com/ctx42/testing/internal/core"
 	"github.com/ctx42/testing/pkg/dump"
 	"github.com/ctx42/testing/pkg/notice"
 )
 
-// Equal recursively checks both values are equal. Returns nil if they are,
-// otherwise it returns an error with a message indicating the expected and
-// actual values.
+// Equal recursively checks both values are equal. Strings are compared after
+// applying scrubbers set with [WithScrubber] or [WithScrubbers] options.
+// Returns nil if they are, otherwise it returns an error with a message
+// indicating the expected and actual values.
 func Equal(want, have any, opts ...Option) error {
 	wVal := reflect.ValueOf(want)
 	hVal := reflect.ValueOf(have)
@@ -246,12 +248,16 @@ func deepEqual(wVal, hVal reflect.Value, opts ...Option) error {
 
 	case reflect.String:
 		ops.logTrail()
-		if wVal.String() == hVal.String() {
+		wStr, hStr := wVal.String(), hVal.String()
+		if wStr == hStr || ops.Dumper.Scrub(wStr) == ops.Dumper.Scrub(hStr) {
 			return nil
 		}
 		return equalError(wVal.Interface(), hVal.Interface(), WithOptions(ops))
 
 	case reflect.Chan, reflect.Func:
+		if knd == reflect.Func && core.SeqArity(wType) > 0 {
+			return equalSeq(wVal, hVal, WithOptions(ops))
+		}
 		ops.logTrail()
 		if wVal.Pointer() == hVal.Pointer() {
 			return nil
@@ -280,15 +286,42 @@ func equalError(want, have any, opts ...Option) *notice.Notice {
 		ops.Dumper.Dumpers[typByte] = dumpByte
 	}
 
+	// Scrubbed values are not used when scrubbing hides the difference.
+	dmp := ops.Dumper
+	wDmp, hDmp := dmp.Any(want), dmp.Any(have)
+	if wDmp == hDmp && len(dmp.Scrubbers) > 0 {
+		dmp.Scrubbers = nil
+		wDmp, hDmp = dmp.Any(want), dmp.Any(have)
+	}
+
 	msg := notice.New("expected values to be equal").
 		Trail(ops.Trail).
-		Want("%s", ops.Dumper.Any(want)).
-		Have("%s", ops.Dumper.Any(have))
+		Want("%s", wDmp).
+		Have("%s", hDmp)
 
 	if wTyp != "" {
 		_ = msg.
 			Append("want type", "%s", wTyp).
 			Append("have type", "%s", hTyp)
+		return msg
+	}
+
+	// Strings which differ by invisible or confusable runes are rendered in
+	// escaped form with the first differing runes described.
+	wVal, hVal := reflect.ValueOf(want), reflect.ValueOf(have)
+	if wVal.Kind() == reflect.String && hVal.Kind() == reflect.String {
+		wStr, hStr := dmp.Scrub(wVal.String()), dmp.Scrub(hVal.String())
+		wOff, hOff := firstDiff(wStr, hStr, equalRune)
+		wr, hr := runeAt(wStr, wOff), runeAt(hStr, hOff)
+		if !isVisibleRune(wr) || !isVisibleRune(hr) {
+			qWant, _ := quoteVisible(wStr, wOff)
+			qHave, _ := quoteVisible(hStr, hOff)
+			_ = msg.
+				Want("%s", qWant).
+				Have("%s", qHave).
+				Append("offset", "%d", hOff)
+			_ = appendRunes(msg, wStr, hStr, wOff, hOff)
+		}
 	}
 	return msg
 }
@@ -3,7 +3,10 @@ package check
 import (
 	"errors"
 	"fmt"
+	"iter"
+	"maps"
 	"reflect"
+	"slices"
 	"testing"
 	"time"
 
@@ -194,6 +197,93 @@ func Test_Equal_custom_trail_checkers(t *testing.T) {
 	})
 }
 
+func Test_Equal_scrubbers(t *testing.T) {
+	t.Run("equal after scrubbing", func(t *testing.T) {
+		// --- Given ---
+		want := map[string]string{"id": "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a"}
+		have := map[string]string{"id": "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"}
+
+		// --- When ---
+		err := Equal(want, have, WithScrubbers(dump.ScrubUUID))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal after scrubbing", func(t *testing.T) {
+		// --- Given ---
+		want := map[string]string{"id": "id: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a"}
+		have := map[string]string{"id": "ID: 0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"}
+		opts := []Option{
+			WithScrubbers(dump.ScrubUUID),
+			WithDumper(dump.WithFlat),
+		}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected values to be equal:\n" +
+			"  trail: map[\"id\"]\n" +
+			"   want: \"id: <uuid>\"\n" +
+			"   have: \"ID: <uuid>\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("raw values when scrubbing hides the difference", func(t *testing.T) {
+		// --- Given ---
+		type ID string
+		want := "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a"
+		have := ID("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d")
+
+		// --- When ---
+		err := Equal(want, have, WithScrubbers(dump.ScrubUUID))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected values to be equal:\n" +
+			"       want: \"7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a\"\n" +
+			"       have: \"0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d\"\n" +
+			"  want type: string\n" +
+			"  have type: check.ID"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbed strings with invisible runes", func(t *testing.T) {
+		// --- Given ---
+		want := "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a a"
+		have := "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d\u00a0a"
+
+		// --- When ---
+		err := Equal(want, have, WithScrubbers(dump.ScrubUUID))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected values to be equal:\n" +
+			"    want: \"<uuid> a\"\n" +
+			"    have: \"<uuid>\\u00a0a\"\n" +
+			"  offset: 6\n" +
+			"   runes: want U+0020 ' ', have U+00A0 '\\u00a0'"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Equal_invalid_UTF8(t *testing.T) {
+	// --- When ---
+	err := Equal("ab\xff", "ab\xfe")
+
+	// --- Then ---
+	affirm.NotNil(t, err)
+	wMsg := "expected values to be equal:\n" +
+		"    want: \"ab\\xff\"\n" +
+		"    have: \"ab\\xfe\"\n" +
+		"  offset: 2\n" +
+		"   runes: want invalid UTF-8 byte \\xff, " +
+		"have invalid UTF-8 byte \\xfe"
+	affirm.Equal(t, wMsg, err.Error())
+}
+
 func Test_Equal_custom_type_checkers(t *testing.T) {
 	t.Run("use custom type checker", func(t *testing.T) {
 		// --- Given ---
@@ -1195,6 +1285,167 @@ func Test_Equal_kind_Func(t *testing.T) {
 	})
 }
 
+func Test_Equal_kind_Func_iterator(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []Option{WithTrailLog(&trail), WithTrail("type.field")}
+
+		want := slices.Values([]int{1, 2})
+		have := slices.Values([]int{1, 2})
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.DeepEqual(t, []string{"type.field[0]", "type.field[1]"}, trail)
+	})
+
+	t.Run("equal - nil and empty", func(t *testing.T) {
+		// --- Given ---
+		var want iter.Seq[int]
+		have := slices.Values([]int{})
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal iter.Seq2", func(t *testing.T) {
+		// --- Given ---
+		want := slices.All([]string{"a", "b"})
+		have := slices.All([]string{"a", "b"})
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal values", func(t *testing.T) {
+		// --- Given ---
+		want := slices.Values([]int{1, 2, 3})
+		have := slices.Values([]int{1, 4, 5})
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected values to be equal:\n" +
+			"  trail: <iter>[1]\n" +
+			"   want: 2\n" +
+			"   have: 4\n" +
+			" ---\n" +
+			"  trail: <iter>[2]\n" +
+			"   want: 3\n" +
+			"   have: 5"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal length", func(t *testing.T) {
+		// --- Given ---
+		want := slices.Values([]int{1, 2})
+		have := slices.Values([]int{1})
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected values to be equal:\n" +
+			"  want len: 2\n" +
+			"  have len: 1\n" +
+			"      want:\n" +
+			"            iter.Seq[int]{\n" +
+			"              1,\n" +
+			"              2,\n" +
+			"            }\n" +
+			"      have:\n" +
+			"            iter.Seq[int]{\n" +
+			"              1,\n" +
+			"            }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal iter.Seq2", func(t *testing.T) {
+		// --- Given ---
+		want := maps.All(map[string]int{"A": 1})
+		have := maps.All(map[string]int{"B": 2})
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected values to be equal:\n" +
+			"  trail: <iter>[0].key\n" +
+			"   want: \"A\"\n" +
+			"   have: \"B\"\n" +
+			" ---\n" +
+			"  trail: <iter>[0].value\n" +
+			"   want: 1\n" +
+			"   have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		// --- Given ---
+		want := types.TSeq{Seq: slices.Values([]int{1})}
+		have := types.TSeq{Seq: slices.Values([]int{2})}
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected values to be equal:\n" +
+			"  trail: TSeq.Seq[0]\n" +
+			"   want: 1\n" +
+			"   have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("over limit", func(t *testing.T) {
+		// --- Given ---
+		seq := func(yield func(int) bool) {
+			for yield(0) {
+			}
+		}
+
+		// --- When ---
+		err := Equal(seq, seq, WithTrail("type.field"))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator to yield at most 1000 items:\n" +
+			"  trail: type.field"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal length one shot iterators", func(t *testing.T) {
+		// --- Given ---
+		want := oneShotSeq(1, 2)
+		have := oneShotSeq(1)
+
+		// --- When ---
+		err := Equal(want, have, WithDumper(dump.WithFlat))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected values to be equal:\n" +
+			"  want len: 2\n" +
+			"  have len: 1\n" +
+			"      want: iter.Seq[int]{1, 2}\n" +
+			"      have: iter.Seq[int]{1}"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
 func Test_Equal_kind_default_Uintptr(t *testing.T) {
 	t.Run("equal", func(t *testing.T) {
 		// --- Given ---
@@ -1383,6 +1634,120 @@ func Test_equalError(t *testing.T) {
 			"  have type: int"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("strings with visible difference", func(t *testing.T) {
+		// --- Given ---
+		ops := DefaultOptions()
+
+		// --- When ---
+		err := equalError("abc", "abd", WithOptions(ops))
+
+		// --- Then ---
+		wMsg := "expected values to be equal:\n" +
+			"  want: \"abc\"\n" +
+			"  have: \"abd\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("strings differing by trailing space", func(t *testing.T) {
+		// --- Given ---
+		ops := DefaultOptions()
+
+		// --- When ---
+		err := equalError("abc", "abc ", WithOptions(ops))
+
+		// --- Then ---
+		wMsg := "expected values to be equal:\n" +
+			"    want: \"abc\"\n" +
+			"    have: \"abc⎵\"\n" +
+			"  offset: 3\n" +
+			"   runes: want <none>, have U+0020 ' '"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("strings differing by non-breaking space", func(t *testing.T) {
+		// --- Given ---
+		ops := DefaultOptions()
+
+		// --- When ---
+		err := equalError("a b", "a\u00a0b", WithOptions(ops))
+
+		// --- Then ---
+		wMsg := "expected values to be equal:\n" +
+			"    want: \"a b\"\n" +
+			"    have: \"a\\u00a0b\"\n" +
+			"  offset: 1\n" +
+			"   runes: want U+0020 ' ', have U+00A0 '\\u00a0'"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("multi line strings differing by line ending", func(t *testing.T) {
+		// --- Given ---
+		ops := DefaultOptions(WithTrail("type.field"))
+
+		// --- When ---
+		err := equalError("a\nb", "a\r\nb", WithOptions(ops))
+
+		// --- Then ---
+		wMsg := "expected values to be equal:\n" +
+			"   trail: type.field\n" +
+			"    want: \"a\\nb\"\n" +
+			"    have: \"a\\r\\nb\"\n" +
+			"  offset: 1\n" +
+			"   runes: want U+000A '\\n', have U+000D '\\r'"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("strings differing by confusable rune", func(t *testing.T) {
+		// --- Given ---
+		ops := DefaultOptions()
+
+		// --- When ---
+		err := equalError("pa", "pа", WithOptions(ops)) // Cyrillic "а".
+
+		// --- Then ---
+		wMsg := "expected values to be equal:\n" +
+			"    want: \"pa\"\n" +
+			"    have: \"pа\"\n" +
+			"  offset: 1\n" +
+			"   runes: want U+0061 'a', have U+0430 'а'"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("strings differing by invalid UTF-8 bytes", func(t *testing.T) {
+		// --- Given ---
+		ops := DefaultOptions()
+
+		// --- When ---
+		err := equalError("ab\xff", "ab\xfe", WithOptions(ops))
+
+		// --- Then ---
+		wMsg := "expected values to be equal:\n" +
+			"    want: \"ab\\xff\"\n" +
+			"    have: \"ab\\xfe\"\n" +
+			"  offset: 2\n" +
+			"   runes: want invalid UTF-8 byte \\xff, " +
+			"have invalid UTF-8 byte \\xfe"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("custom string type", func(t *testing.T) {
+		// --- Given ---
+		w := types.TStrType("a\t")
+		h := types.TStrType("a ")
+		ops := DefaultOptions()
+
+		// --- When ---
+		err := equalError(w, h, WithOptions(ops))
+
+		// --- Then ---
+		wMsg := "expected values to be equal:\n" +
+			"    want: \"a\\t\"\n" +
+			"    have: \"a⎵\"\n" +
+			"  offset: 1\n" +
+			"   runes: want U+0009 '\\t', have U+0020 ' '"
+		affirm.Equal(t, wMsg, err.Error())
+	})
 }
 
 func Test_dumpByte(t *testing.T) {
@@ -267,8 +267,21 @@ func ExampleJSON() {
 	fmt.Println(err)
 	// Output:
 	// expected JSON strings to be equal:
-	//   want: {"A":1,"B":2}
-	//   have: {"A":1,"B":3}
+	//   want:
+	//         {
+	//           "A": 1,
+	//           "B": 2
+	//         }
+	//   have:
+	//         {
+	//           "A": 1,
+	//           "B": 3
+	//         }
+	//
+	// expected JSON values to be equal:
+	//   pointer: /B
+	//      want: 2
+	//      have: 3
 }
 
 func ExampleTime() {
@@ -4,63 +4,46 @@
 package check
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
 	"os"
+	"slices"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/ctx42/testing/pkg/notice"
 )
 
 // FileExist checks "pth" points to an existing file. Returns an error if the
 // path points to a filesystem entry which is not a file or there is an error
-// when trying to check the path. On success, it returns nil.
+// when trying to check the path. Use [WithFS] option to check the path in any
+// [fs.FS] filesystem. On success, it returns nil.
 func FileExist(pth string, opts ...Option) error {
-	inf, err := os.Lstat(pth)
-	if err != nil {
-		ops := DefaultOptions(opts...)
-		if os.IsNotExist(err) {
-			return notice.New("expected path to an existing file").
-				Trail(ops.Trail).
-				Append("path", "%s", pth)
-		}
-		return notice.New("expected os.Lstat to succeed").
-			Trail(ops.Trail).
-			Append("path", "%s", pth).
-			Append("error", "%s", err)
-	}
-	if inf.IsDir() {
-		ops := DefaultOptions(opts...)
-		return notice.New("expected path to be existing file").
-			Trail(ops.Trail).
-			Append("path", "%s", pth)
-	}
-	return nil
+	_, err := DefaultOptions(opts...).statFile(pth, false)
+	return err
 }
 
 // NoFileExist checks "pth" points to not existing file. Returns an error if
-// the path points to an existing filesystem entry. On success, it returns nil.
+// the path points to an existing filesystem entry. Use [WithFS] option to
+// check the path in any [fs.FS] filesystem. On success, it returns nil.
 func NoFileExist(pth string, opts ...Option) error {
-	inf, err := os.Lstat(pth)
+	ops := DefaultOptions(opts...)
+	inf, err := ops.lstat(pth)
 	if err != nil {
-		if os.IsNotExist(err) {
+		if errors.Is(err, fs.ErrNotExist) {
 			return nil
 		}
-		ops := DefaultOptions(opts...)
-		return notice.New("expected os.Lstat to succeed").
-			Trail(ops.Trail).
-			Append("path", "%s", pth).
+		return ops.fsNotice("expected %s to succeed", pth, ops.lstatName()).
 			Append("error", "%s", err)
 	}
 	if inf.IsDir() {
-		ops := DefaultOptions(opts...)
-		return notice.New("expected path to be not existing file").
-			Trail(ops.Trail).
-			Append("path", "%s", pth)
+		return ops.fsNotice("expected path to be not existing file", pth)
 	}
-
-	ops := DefaultOptions(opts...)
-	return notice.New("expected path to not existing file").
-		Trail(ops.Trail).
-		Append("path", "%s", pth)
+	return ops.fsNotice("expected path to not existing file", pth)
 }
 
 // Content declares type constraint for file content.
@@ -71,82 +54,522 @@ type Content interface {
 // FileContain checks file at "pth" can be read and its string content contains
 // "want". It fails if the path points to a filesystem entry which is not a
 // file or there is an error reading the file. The file is read in full then
-// [strings.Contains] is used to check it contains "want" string. When it fails
-// it returns an error with a message indicating the expected and actual values.
+// [strings.Contains] is used to check it contains "want" string. Use [WithFS]
+// option to read the file from any [fs.FS] filesystem. When it fails it
+// returns an error with a message indicating the expected and actual values.
 func FileContain[T Content](want T, pth string, opts ...Option) error {
-	content, err := os.ReadFile(pth)
+	ops := DefaultOptions(opts...)
+	content, err := ops.readFile(pth)
 	if err != nil {
-		ops := DefaultOptions(opts...)
-		return notice.New("expected no error reading file").
-			Trail(ops.Trail).
-			Append("path", "%s", pth).
+		return ops.fsNotice("expected no error reading file", pth).
 			Append("error", "%s", err)
 	}
 	if strings.Contains(string(content), string(want)) {
 		return nil
 	}
-
-	ops := DefaultOptions(opts...)
-	return notice.New("expected file to contain string").
-		Trail(ops.Trail).
-		Append("path", "%s", pth).
+	return ops.fsNotice("expected file to contain string", pth).
 		Want("%q", want)
 }
 
 // DirExist checks "pth" points to an existing directory. It fails if the path
 // points to a filesystem entry which is not a directory or there is an error
-// when trying to check the path. When it fails it returns an error with a
-// detailed message indicating the expected and actual values.
+// when trying to check the path. Use [WithFS] option to check the path in any
+// [fs.FS] filesystem. When it fails it returns an error with a detailed
+// message indicating the expected and actual values.
 func DirExist(pth string, opts ...Option) error {
-	inf, err := os.Lstat(pth)
+	ops := DefaultOptions(opts...)
+	inf, err := ops.lstat(pth)
 	if err != nil {
-		if os.IsNotExist(err) {
-			ops := DefaultOptions(opts...)
-			return notice.New("expected path to an existing directory").
-				Trail(ops.Trail).
-				Append("path", "%s", pth)
+		if errors.Is(err, fs.ErrNotExist) {
+			return ops.fsNotice("expected path to an existing directory", pth)
 		}
-
-		ops := DefaultOptions(opts...)
-		return notice.New("expected os.Lstat to succeed").
-			Trail(ops.Trail).
-			Append("path", "%s", pth).
+		return ops.fsNotice("expected %s to succeed", pth, ops.lstatName()).
 			Append("error", "%s", err)
 	}
 	if !inf.IsDir() {
-		ops := DefaultOptions(opts...)
-		return notice.New("expected path to be existing directory").
-			Trail(ops.Trail).
-			Append("path", "%s", pth)
+		return ops.fsNotice("expected path to be existing directory", pth)
 	}
 	return nil
 }
 
 // NoDirExist checks "pth" points to not existing directory. It fails if the
-// path points to an existing filesystem entry. When it fails it returns an
-// error with a detailed message indicating the expected and actual values.
+// path points to an existing filesystem entry. Use [WithFS] option to check
+// the path in any [fs.FS] filesystem. When it fails it returns an error with
+// a detailed message indicating the expected and actual values.
 func NoDirExist(pth string, opts ...Option) error {
-	inf, err := os.Lstat(pth)
+	ops := DefaultOptions(opts...)
+	inf, err := ops.lstat(pth)
 	if err != nil {
-		if os.IsNotExist(err) {
+		if errors.Is(err, fs.ErrNotExist) {
 			return nil
 		}
+		return ops.fsNotice("expected %s to succeed", pth, ops.lstatName()).
+			Append("error", "%s", err)
+	}
+	if !inf.IsDir() {
+		return ops.fsNotice("expected path to be not existing directory", pth)
+	}
+	return ops.fsNotice("expected path to not existing directory", pth)
+}
 
-		ops := DefaultOptions(opts...)
-		return notice.New("expected os.Lstat to succeed").
+// FileEqual checks content of the file at "pth" is equal to "want". When it
+// fails it returns an error with a message indicating the first differing
+// line, or the differing byte offset for binary files.
+func FileEqual[T Content](want T, pth string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	have, err := ops.readFile(pth)
+	if err != nil {
+		return ops.fsNotice("expected no error reading file", pth).
+			Append("error", "%s", err)
+	}
+	wData, hData := ops.scrubText([]byte(want)), ops.scrubText(have)
+	if bytes.Equal(wData, hData) {
+		return nil
+	}
+	msg := ops.fsNotice("expected file content to be equal", pth)
+	return contentDiff(msg, wData, hData)
+}
+
+// FilesEqual checks files at "want" and "have" paths have equal content. When
+// it fails it returns an error with a message indicating the first differing
+// line, or the differing byte offset for binary files.
+func FilesEqual(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	wData, err := ops.readFile(want)
+	if err != nil {
+		return ops.fsNotice("expected no error reading file", want).
+			Append("error", "%s", err)
+	}
+	hData, err := ops.readFile(have)
+	if err != nil {
+		return ops.fsNotice("expected no error reading file", have).
+			Append("error", "%s", err)
+	}
+	wData, hData = ops.scrubText(wData), ops.scrubText(hData)
+	if bytes.Equal(wData, hData) {
+		return nil
+	}
+	msg := notice.New("expected files to be equal").Trail(ops.Trail)
+	if ops.FS != nil {
+		_ = msg.Append("fs", "%T", ops.FS)
+	}
+	_ = msg.
+		Append("want path", "%s", ops.Dumper.Scrub(want)).
+		Append("have path", "%s", ops.Dumper.Scrub(have))
+	return contentDiff(msg, wData, hData)
+}
+
+// FileRegexp checks content of the file at "pth" matches "want" regular
+// expression. The "want" can be either regular expression string or instance
+// of [regexp.Regexp]. When it fails it returns an error with a message
+// indicating the path and the regular expression.
+func FileRegexp(want any, pth string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	have, err := ops.readFile(pth)
+	if err != nil {
+		return ops.fsNotice("expected no error reading file", pth).
+			Append("error", "%s", err)
+	}
+	match, err := matchRegexp(want, string(have))
+	if err != nil {
+		return notice.New("expected valid regexp").
 			Trail(ops.Trail).
-			Append("path", "%s", pth).
+			Append("error", "%q", err)
+	}
+	if match {
+		return nil
+	}
+	return ops.fsNotice("expected file to match regexp", pth).
+		Append("regexp", "%s", want)
+}
+
+// FileJSON checks content of the file at "pth" is JSON document equivalent to
+// "want" as defined by the [JSON] check. When it fails it returns the [JSON]
+// check errors with the path added to each message.
+func FileJSON(want, pth string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	have, err := ops.readFile(pth)
+	if err != nil {
+		return ops.fsNotice("expected no error reading file", pth).
 			Append("error", "%s", err)
 	}
-	if !inf.IsDir() {
+	if err = JSON(want, string(have), opts...); err == nil {
+		return nil
+	}
+	ers := notice.Unwrap(err)
+	for _, e := range ers {
+		var msg *notice.Notice
+		if errors.As(e, &msg) {
+			_ = msg.Prepend("path", "%s", ops.Dumper.Scrub(pth))
+			if ops.FS != nil {
+				_ = msg.Prepend("fs", "%T", ops.FS)
+			}
+		}
+	}
+	return wrap(errors.Join(ers...))
+}
+
+// FileLines checks lines of the file at "pth" are equal to "want". The new
+// line at the end of the file is optional. When it fails it returns an error
+// with a message indicating the first differing line.
+func FileLines(want []string, pth string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	have, err := ops.readFile(pth)
+	if err != nil {
+		return ops.fsNotice("expected no error reading file", pth).
+			Append("error", "%s", err)
+	}
+	hLines := fileLines(string(ops.scrubText(have)))
+	wLines := make([]string, 0, len(want))
+	for _, line := range want {
+		wLines = append(wLines, ops.Dumper.Scrub(line))
+	}
+	if slices.Equal(wLines, hLines) {
+		return nil
+	}
+	msg := ops.fsNotice("expected file lines to be equal", pth)
+	if len(wLines) == 0 || len(hLines) == 0 {
+		return msg.
+			Append("want lines", "%d", len(wLines)).
+			Append("have lines", "%d", len(hLines))
+	}
+	err = LinesEqual(strings.Join(wLines, "\n"), strings.Join(hLines, "\n"))
+	return appendRows(msg, err)
+}
+
+// FileLineCount checks the file at "pth" has "want" number of lines. The new
+// line at the end of the file is optional. When it fails it returns an error
+// with a message indicating the expected and actual values.
+func FileLineCount(want int, pth string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	have, err := ops.readFile(pth)
+	if err != nil {
+		return ops.fsNotice("expected no error reading file", pth).
+			Append("error", "%s", err)
+	}
+	cnt := len(fileLines(string(have)))
+	if cnt == want {
+		return nil
+	}
+	return ops.fsNotice("expected file line count", pth).
+		Want("%d", want).
+		Have("%d", cnt)
+}
+
+// FileMode checks "pth" points to a filesystem entry with the "want" mode.
+// Only permission bits are compared unless "want" has any of the type bits
+// ([fs.ModeType]) set. Symbolic links are followed unless "want" has the
+// [fs.ModeSymlink] bit set, then the mode of the link itself is checked. When
+// it fails it returns an error with a message showing modes in octal and
+// symbolic notation.
+//
+// Example:
+//
+//	check.FileMode(0o750, "bin/run.sh")
+func FileMode(want fs.FileMode, pth string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	inf, err := ops.statPath(pth, want&fs.ModeSymlink == 0)
+	if err != nil {
+		return err
+	}
+	have := inf.Mode()
+	if want.Type() == 0 {
+		have = have.Perm()
+	}
+	if want == have {
+		return nil
+	}
+	return ops.fsNotice("expected file mode", pth).
+		Want("%s", fileMode(want)).
+		Have("%s", fileMode(inf.Mode()))
+}
+
+// FileSize checks "pth" points to a file with size of "want" bytes. Symbolic
+// links are followed. When it fails it returns an error with a message
+// indicating the expected and actual values.
+func FileSize(want int64, pth string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	inf, err := ops.statFile(pth, true)
+	if err != nil {
+		return err
+	}
+	if inf.Size() == want {
+		return nil
+	}
+	return ops.fsNotice("expected file size", pth).
+		Want("%d", want).
+		Have("%d", inf.Size())
+}
+
+// FileEmpty checks "pth" points to an empty file. Symbolic links are
+// followed. When it fails it returns an error with a message indicating the
+// file size.
+func FileEmpty(pth string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	inf, err := ops.statFile(pth, true)
+	if err != nil {
+		return err
+	}
+	if inf.Size() == 0 {
+		return nil
+	}
+	return ops.fsNotice("expected file to be empty", pth).
+		Append("size", "%d", inf.Size())
+}
+
+// SymlinkTo checks "pth" is a symbolic link pointing to "target". The target
+// is compared as it is stored in the link, it is not resolved. The check
+// always uses the operating system filesystem. When it fails it returns an
+// error with a message indicating the expected and actual values.
+func SymlinkTo(target, pth string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	ops.FS = nil
+	inf, err := ops.statPath(pth, false)
+	if err != nil {
+		return err
+	}
+	if inf.Mode()&fs.ModeSymlink == 0 {
+		return ops.fsNotice("expected path to be a symlink", pth).
+			Append("mode", "%s", fileMode(inf.Mode()))
+	}
+	have, err := os.Readlink(pth)
+	if err != nil {
+		return ops.fsNotice("expected os.Readlink to succeed", pth).
+			Append("error", "%s", err)
+	}
+	if have == target {
+		return nil
+	}
+	return ops.fsNotice("expected symlink target", pth).
+		Want("%s", target).
+		Have("%s", have)
+}
+
+// IsExecutable checks "pth" points to a file with any of the executable
+// permission bits set. Symbolic links are followed. When it fails it returns
+// an error with a message showing the file mode in octal and symbolic
+// notation.
+func IsExecutable(pth string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	inf, err := ops.statFile(pth, true)
+	if err != nil {
+		return err
+	}
+	if inf.Mode().Perm()&0o111 != 0 {
+		return nil
+	}
+	return ops.fsNotice("expected file to be executable", pth).
+		Append("mode", "%s", fileMode(inf.Mode()))
+}
+
+// FileModifiedWithin checks file or directory at "pth" was modified within
+// duration "within" from the current time. Symbolic links are followed. When
+// it fails it returns an error with a message indicating the modification
+// time and the difference.
+func FileModifiedWithin(
+	within time.Duration,
+	pth string,
+	opts ...Option,
+) error {
+
+	ops := DefaultOptions(opts...)
+	inf, err := ops.statPath(pth, true)
+	if err != nil {
+		return err
+	}
+	diff := ops.now().Sub(inf.ModTime())
+	if math.Abs(float64(diff)) <= math.Abs(float64(within)) {
+		return nil
+	}
+	return ops.fsNotice("expected file to be modified within", pth).
+		Append("modified", "%s", inf.ModTime().Format(time.RFC3339Nano)).
+		Append("max diff +/-", "%s", within.String()).
+		Append("have diff", "%s", diff.String())
+}
+
+// SameFile checks "want" and "have" paths point to the same file as reported
+// by [os.SameFile]. Symbolic links are followed. The check always uses the
+// operating system filesystem. When it fails it returns an error with a
+// message indicating both paths.
+func SameFile(want, have string, opts ...Option) error {
+	wInf, wErr := os.Stat(want)
+	hInf, hErr := os.Stat(have)
+	if err := errors.Join(wErr, hErr); err != nil {
 		ops := DefaultOptions(opts...)
-		return notice.New("expected path to be not existing directory").
+		return notice.New("expected os.Stat to succeed").
 			Trail(ops.Trail).
-			Append("path", "%s", pth)
+			Append("error", "%s", err)
+	}
+	if os.SameFile(wInf, hInf) {
+		return nil
 	}
-
 	ops := DefaultOptions(opts...)
-	return notice.New("expected path to not existing directory").
+	return notice.New("expected paths to point to the same file").
 		Trail(ops.Trail).
-		Append("path", "%s", pth)
+		Want("%s", want).
+		Have("%s", have)
+}
+
+// statPath returns file information for "pth" or notice describing why it
+// cannot be obtained. Symbolic links are followed when "follow" is true.
+func (ops Options) statPath(pth string, follow bool) (fs.FileInfo, error) {
+	stat, name := ops.statFunc(follow)
+	inf, err := stat(pth)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ops.fsNotice("expected path to exist", pth)
+		}
+		return nil, ops.fsNotice("expected %s to succeed", pth, name).
+			Append("error", "%s", err)
+	}
+	return inf, nil
+}
+
+// statFile returns file information for "pth" or notice describing why it
+// cannot be obtained or that the path does not point to a file. Symbolic
+// links are followed when "follow" is true.
+func (ops Options) statFile(pth string, follow bool) (fs.FileInfo, error) {
+	stat, name := ops.statFunc(follow)
+	inf, err := stat(pth)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ops.fsNotice("expected path to an existing file", pth)
+		}
+		return nil, ops.fsNotice("expected %s to succeed", pth, name).
+			Append("error", "%s", err)
+	}
+	if inf.IsDir() {
+		return nil, ops.fsNotice("expected path to be existing file", pth)
+	}
+	return inf, nil
+}
+
+// statFunc returns the function returning file information and its name.
+// The function follows symbolic links when "follow" is true.
+func (ops Options) statFunc(
+	follow bool,
+) (func(string) (fs.FileInfo, error), string) {
+
+	if follow {
+		return ops.stat, ops.statName()
+	}
+	return ops.lstat, ops.lstatName()
+}
+
+// stat returns file information for "pth" using [fs.Stat] when [Options.FS]
+// is set, otherwise using [os.Stat].
+func (ops Options) stat(pth string) (fs.FileInfo, error) {
+	if ops.FS != nil {
+		return fs.Stat(ops.FS, pth)
+	}
+	return os.Stat(pth)
+}
+
+// statName returns name of the function used by [Options.stat].
+func (ops Options) statName() string {
+	if ops.FS != nil {
+		return "fs.Stat"
+	}
+	return "os.Stat"
+}
+
+// lstat returns file information for "pth" using [fs.Stat] when
+// [Options.FS] is set, otherwise using [os.Lstat].
+func (ops Options) lstat(pth string) (fs.FileInfo, error) {
+	if ops.FS != nil {
+		return fs.Stat(ops.FS, pth)
+	}
+	return os.Lstat(pth)
+}
+
+// lstatName returns name of the function used by [Options.lstat].
+func (ops Options) lstatName() string {
+	if ops.FS != nil {
+		return "fs.Stat"
+	}
+	return "os.Lstat"
+}
+
+// readFile reads file at "pth" using [fs.ReadFile] when [Options.FS] is set,
+// otherwise using [os.ReadFile].
+func (ops Options) readFile(pth string) ([]byte, error) {
+	if ops.FS != nil {
+		return fs.ReadFile(ops.FS, pth)
+	}
+	return os.ReadFile(pth)
+}
+
+// fsNotice returns a new notice with the path row. When [Options.FS] is set
+// the "fs" row with the filesystem type is added before the path.
+func (ops Options) fsNotice(header, pth string, args ...any) *notice.Notice {
+	msg := notice.New(header, args...).Trail(ops.Trail)
+	if ops.FS != nil {
+		_ = msg.Append("fs", "%T", ops.FS)
+	}
+	return msg.Append("path", "%s", ops.Dumper.Scrub(pth))
+}
+
+// scrubText applies scrubbers to the text data. Binary data is returned as
+// is.
+func (ops Options) scrubText(data []byte) []byte {
+	if len(ops.Dumper.Scrubbers) == 0 || !isText(data) {
+		return data
+	}
+	return []byte(ops.Dumper.Scrub(string(data)))
+}
+
+// fileMode returns file mode in octal and symbolic notation.
+//
+// Example:
+//
+//	0750 -rwxr-x---
+func fileMode(mode fs.FileMode) string {
+	return fmt.Sprintf("%04o %s", mode.Perm(), mode)
+}
+
+// fileLines splits file content to lines. The new line at the end of the
+// content is not treated as a start of the next line.
+func fileLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	lines := splitLines(content)
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// contentDiff appends rows describing the first difference between "want"
+// and "have" file contents to the message. For text content, the first
+// differing line is described, otherwise the content sizes and the offset of
+// the first differing byte.
+func contentDiff(msg *notice.Notice, want, have []byte) *notice.Notice {
+	if !isText(want) || !isText(have) {
+		off := 0
+		for off < min(len(want), len(have)) && want[off] == have[off] {
+			off++
+		}
+		return msg.
+			Append("want size", "%d", len(want)).
+			Append("have size", "%d", len(have)).
+			Append("offset", "%d", off)
+	}
+	return appendRows(msg, LinesEqual(string(want), string(have)))
+}
+
+// appendRows appends rows of the notice in "err" to the message.
+func appendRows(msg *notice.Notice, err error) *notice.Notice {
+	var src *notice.Notice
+	if errors.As(err, &src) {
+		for _, name := range src.Order {
+			_ = msg.Append(name, "%s", src.Rows[name])
+		}
+	}
+	return msg
+}
+
+// isText returns true if data is valid UTF-8 without NUL bytes.
+func isText(data []byte) bool {
+	return utf8.Valid(data) && bytes.IndexByte(data, 0) == -1
 }
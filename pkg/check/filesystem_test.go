@@ -4,9 +4,16 @@
 package check
 
 import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
+	"testing/fstest"
+	"time"
 
 	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/dump"
 )
 
 func Test_FileExist(t *testing.T) {
@@ -47,6 +54,70 @@ func Test_FileExist(t *testing.T) {
 			"   path: testdata/dir"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("exists in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := FileExist("dir/file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error does not exist in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := FileExist("dir/other.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to an existing file:\n" +
+			"    fs: fstest.MapFS\n" +
+			"  path: dir/other.txt"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error is a directory in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := FileExist("dir", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to be existing file:\n" +
+			"    fs: fstest.MapFS\n" +
+			"  path: dir"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error invalid fs path", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := FileExist("/file.txt", WithFS(os.DirFS("testdata")), opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected fs.Stat to succeed:\n" +
+			"  trail: type.field\n" +
+			"     fs: os.dirFS\n" +
+			"   path: /file.txt\n" +
+			"  error: stat /file.txt: invalid argument"
+		affirm.Equal(t, wMsg, err.Error())
+	})
 }
 
 func Test_NoFileExist(t *testing.T) {
@@ -83,6 +154,36 @@ func Test_NoFileExist(t *testing.T) {
 			"   path: testdata/dir"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("does not exist in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := NoFileExist("dir/other.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("exists in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := NoFileExist("dir/file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to not existing file:\n" +
+			"    fs: fstest.MapFS\n" +
+			"  path: dir/file.txt"
+		affirm.Equal(t, wMsg, err.Error())
+	})
 }
 
 func Test_FileContain(t *testing.T) {
@@ -157,6 +258,55 @@ func Test_FileContain(t *testing.T) {
 			"  error: read testdata/dir: is a directory"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("contains string in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := FileContain("tent", "dir/file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("does not contain string in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := FileContain("not there", "dir/file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file to contain string:\n" +
+			"    fs: fstest.MapFS\n" +
+			"  path: dir/file.txt\n" +
+			"  want: \"not there\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("file does not exist in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := FileContain("content", "dir/other.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error reading file:\n" +
+			"     fs: fstest.MapFS\n" +
+			"   path: dir/other.txt\n" +
+			"  error: open dir/other.txt: file does not exist"
+		affirm.Equal(t, wMsg, err.Error())
+	})
 }
 
 func Test_DirExist(t *testing.T) {
@@ -197,6 +347,53 @@ func Test_DirExist(t *testing.T) {
 			"   path: testdata/file.txt"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("exists in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := DirExist("dir", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("does not exist in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := DirExist("other", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to an existing directory:\n" +
+			"    fs: fstest.MapFS\n" +
+			"  path: other"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("is a file in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := DirExist("dir/file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to be existing directory:\n" +
+			"    fs: fstest.MapFS\n" +
+			"  path: dir/file.txt"
+		affirm.Equal(t, wMsg, err.Error())
+	})
 }
 
 func Test_NoDirExist(t *testing.T) {
@@ -237,4 +434,945 @@ func Test_NoDirExist(t *testing.T) {
 			"   path: testdata/file.txt"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("does not exist in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := NoDirExist("other", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("exists in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"dir/file.txt": &fstest.MapFile{Data: []byte("content")},
+		}
+
+		// --- When ---
+		err := NoDirExist("dir", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to not existing directory:\n" +
+			"    fs: fstest.MapFS\n" +
+			"  path: dir"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_FileMode(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "run.sh")
+		affirm.Nil(t, os.WriteFile(pth, nil, 0o600))
+		affirm.Nil(t, os.Chmod(pth, 0o750))
+
+		// --- When ---
+		err := FileMode(0o750, pth)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal with type bits", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		affirm.Nil(t, os.Chmod(dir, 0o700))
+
+		// --- When ---
+		err := FileMode(fs.ModeDir|0o700, dir)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal in fs", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{Mode: 0o640}}
+
+		// --- When ---
+		err := FileMode(0o640, "file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("symlink is followed", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		target := filepath.Join(dir, "run.sh")
+		affirm.Nil(t, os.WriteFile(target, nil, 0o600))
+		affirm.Nil(t, os.Chmod(target, 0o640))
+		pth := filepath.Join(dir, "link")
+		affirm.Nil(t, os.Symlink(target, pth))
+
+		// --- When ---
+		err := FileMode(0o640, pth)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("symlink itself with symlink type bit", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "link")
+		affirm.Nil(t, os.Symlink("target.txt", pth))
+		inf, err := os.Lstat(pth)
+		affirm.Nil(t, err)
+
+		// --- When ---
+		err = FileMode(fs.ModeSymlink|inf.Mode().Perm(), pth)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "run.sh")
+		affirm.Nil(t, os.WriteFile(pth, nil, 0o600))
+		affirm.Nil(t, os.Chmod(pth, 0o640))
+
+		// --- When ---
+		err := FileMode(0o750, pth)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file mode:\n" +
+			"  path: " + pth + "\n" +
+			"  want: 0750 -rwxr-x---\n" +
+			"  have: 0640 -rw-r-----"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal type bits", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{Mode: 0o755}}
+
+		// --- When ---
+		err := FileMode(fs.ModeDir|0o755, "file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file mode:\n" +
+			"    fs: fstest.MapFS\n" +
+			"  path: file.txt\n" +
+			"  want: 0755 drwxr-xr-x\n" +
+			"  have: 0755 -rwxr-xr-x"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error does not exist", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := FileMode(0o750, "testdata/not_existing.txt", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to exist:\n" +
+			"  trail: type.field\n" +
+			"   path: testdata/not_existing.txt"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_FileSize(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- When ---
+		err := FileSize(23, "testdata/file.txt")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("symlink is followed", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "link")
+		target, err := filepath.Abs("testdata/file.txt")
+		affirm.Nil(t, err)
+		affirm.Nil(t, os.Symlink(target, pth))
+
+		// --- When ---
+		err = FileSize(23, pth)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{Data: []byte("abc")}}
+
+		// --- When ---
+		err := FileSize(2, "file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file size:\n" +
+			"    fs: fstest.MapFS\n" +
+			"  path: file.txt\n" +
+			"  want: 2\n" +
+			"  have: 3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error is a directory", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := FileSize(0, "testdata/dir", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to be existing file:\n" +
+			"  trail: type.field\n" +
+			"   path: testdata/dir"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_FileEmpty(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{}}
+
+		// --- When ---
+		err := FileEmpty("file.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("symlink is followed", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		target := filepath.Join(dir, "file.txt")
+		affirm.Nil(t, os.WriteFile(target, nil, 0o600))
+		pth := filepath.Join(dir, "link")
+		affirm.Nil(t, os.Symlink(target, pth))
+
+		// --- When ---
+		err := FileEmpty(pth)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not empty", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := FileEmpty("testdata/file.txt", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file to be empty:\n" +
+			"  trail: type.field\n" +
+			"   path: testdata/file.txt\n" +
+			"   size: 23"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error does not exist", func(t *testing.T) {
+		// --- When ---
+		err := FileEmpty("testdata/not_existing.txt")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to an existing file:\n" +
+			"  path: testdata/not_existing.txt"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_SymlinkTo(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "link")
+		affirm.Nil(t, os.Symlink("target.txt", pth))
+
+		// --- When ---
+		err := SymlinkTo("target.txt", pth)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "link")
+		affirm.Nil(t, os.Symlink("other.txt", pth))
+
+		// --- When ---
+		err := SymlinkTo("target.txt", pth)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected symlink target:\n" +
+			"  path: " + pth + "\n" +
+			"  want: target.txt\n" +
+			"  have: other.txt"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error not a symlink", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "file.txt")
+		affirm.Nil(t, os.WriteFile(pth, nil, 0o600))
+		affirm.Nil(t, os.Chmod(pth, 0o600))
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := SymlinkTo("target.txt", pth, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to be a symlink:\n" +
+			"  trail: type.field\n" +
+			"   path: " + pth + "\n" +
+			"   mode: 0600 -rw-------"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error does not exist", func(t *testing.T) {
+		// --- When ---
+		err := SymlinkTo("target.txt", "testdata/not_existing")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to exist:\n" +
+			"  path: testdata/not_existing"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_IsExecutable(t *testing.T) {
+	t.Run("executable", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"run.sh": &fstest.MapFile{Mode: 0o744}}
+
+		// --- When ---
+		err := IsExecutable("run.sh", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not executable", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"run.sh": &fstest.MapFile{Mode: 0o644}}
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := IsExecutable("run.sh", WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file to be executable:\n" +
+			"  trail: type.field\n" +
+			"     fs: fstest.MapFS\n" +
+			"   path: run.sh\n" +
+			"   mode: 0644 -rw-r--r--"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("symlink to not executable file", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		target := filepath.Join(dir, "run.sh")
+		affirm.Nil(t, os.WriteFile(target, nil, 0o600))
+		affirm.Nil(t, os.Chmod(target, 0o644))
+		pth := filepath.Join(dir, "link")
+		affirm.Nil(t, os.Symlink(target, pth))
+
+		// --- When ---
+		err := IsExecutable(pth)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file to be executable:\n" +
+			"  path: " + pth + "\n" +
+			"  mode: 0644 -rw-r--r--"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error is a directory", func(t *testing.T) {
+		// --- When ---
+		err := IsExecutable("testdata/dir")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected path to be existing file:\n" +
+			"  path: testdata/dir"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_FileModifiedWithin(t *testing.T) {
+	t.Run("within", func(t *testing.T) {
+		// --- Given ---
+		mod := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{ModTime: mod}}
+		now := func() time.Time { return mod.Add(time.Second) }
+		opts := []Option{WithFS(fsys), WithNow(now)}
+
+		// --- When ---
+		err := FileModifiedWithin(time.Second, "file.txt", opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("within real file", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "file.txt")
+		affirm.Nil(t, os.WriteFile(pth, nil, 0o600))
+
+		// --- When ---
+		err := FileModifiedWithin(time.Minute, pth)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not within", func(t *testing.T) {
+		// --- Given ---
+		mod := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{ModTime: mod}}
+		now := func() time.Time { return mod.Add(2 * time.Second) }
+		opts := []Option{WithFS(fsys), WithNow(now), WithTrail("type.field")}
+
+		// --- When ---
+		err := FileModifiedWithin(time.Second, "file.txt", opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file to be modified within:\n" +
+			"         trail: type.field\n" +
+			"            fs: fstest.MapFS\n" +
+			"          path: file.txt\n" +
+			"      modified: 2025-01-01T00:00:00Z\n" +
+			"  max diff +/-: 1s\n" +
+			"     have diff: 2s"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_SameFile(t *testing.T) {
+	t.Run("same", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "link")
+		target, err := filepath.Abs("testdata/file.txt")
+		affirm.Nil(t, err)
+		affirm.Nil(t, os.Symlink(target, pth))
+
+		// --- When ---
+		err = SameFile("testdata/file.txt", pth)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not same", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := SameFile("testdata/file.txt", "testdata/dir", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected paths to point to the same file:\n" +
+			"  trail: type.field\n" +
+			"   want: testdata/file.txt\n" +
+			"   have: testdata/dir"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error does not exist", func(t *testing.T) {
+		// --- When ---
+		err := SameFile("testdata/file.txt", "testdata/not_existing")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected os.Stat to succeed:\n" +
+			"  error: stat testdata/not_existing: no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_FileEqual(t *testing.T) {
+	t.Run("equal string", func(t *testing.T) {
+		// --- When ---
+		err := FileEqual("abc def ghi\njkl mno pqr", "testdata/file.txt")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal byte slice", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"file.bin": &fstest.MapFile{Data: []byte{0, 1}}}
+
+		// --- When ---
+		err := FileEqual([]byte{0, 1}, "file.bin", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := FileEqual("abc def ghi\njkl mno xyz", "testdata/file.txt", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file content to be equal:\n" +
+			"   trail: type.field\n" +
+			"    path: testdata/file.txt\n" +
+			"    line: 2\n" +
+			"    want: \"jkl mno xyz\"\n" +
+			"    have: \"jkl mno pqr\"\n" +
+			"  offset: 8\n" +
+			"    diff:\n" +
+			"          \"jkl mno xyz\"\n" +
+			"          \"jkl mno pqr\"\n" +
+			"                   ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal binary", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"file.bin": &fstest.MapFile{Data: []byte{0, 1}}}
+
+		// --- When ---
+		err := FileEqual([]byte{0, 2, 3}, "file.bin", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file content to be equal:\n" +
+			"         fs: fstest.MapFS\n" +
+			"       path: file.bin\n" +
+			"  want size: 3\n" +
+			"  have size: 2\n" +
+			"     offset: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error does not exist", func(t *testing.T) {
+		// --- When ---
+		err := FileEqual("abc", "testdata/not_existing.txt")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error reading file:\n" +
+			"   path: testdata/not_existing.txt\n" +
+			"  error: open testdata/not_existing.txt: no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		data := []byte("id: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a\n")
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{Data: data}}
+		opts := []Option{WithFS(fsys), WithScrubbers(dump.ScrubUUID)}
+
+		// --- When ---
+		err := FileEqual("id: <uuid>\n", "file.txt", opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal with scrubbed path", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "file.txt")
+		affirm.Nil(t, os.WriteFile(pth, []byte("abc"), 0o600))
+		opt := WithScrubbers(dump.ScrubTempDir)
+
+		// --- When ---
+		err := FileEqual("xyz", pth, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file content to be equal:\n" +
+			"    path: " + filepath.Join("<tempdir>", "file.txt") + "\n" +
+			"    line: 1\n" +
+			"    want: \"xyz\"\n" +
+			"    have: \"abc\"\n" +
+			"  offset: 0\n" +
+			"    diff:\n" +
+			"          \"xyz\"\n" +
+			"          \"abc\"\n" +
+			"           ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_FilesEqual(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- When ---
+		err := FilesEqual("testdata/file.txt", "testdata/file.txt")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"a.txt": &fstest.MapFile{Data: []byte("a\nb\nc\n")},
+			"b.txt": &fstest.MapFile{Data: []byte("a\nb\n")},
+		}
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := FilesEqual("a.txt", "b.txt", WithFS(fsys), opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected files to be equal:\n" +
+			"      trail: type.field\n" +
+			"         fs: fstest.MapFS\n" +
+			"  want path: a.txt\n" +
+			"  have path: b.txt\n" +
+			"       line: 3\n" +
+			"       want: \"c\"\n" +
+			"       have: \"\"\n" +
+			"     offset: 0\n" +
+			"       diff:\n" +
+			"             \"c\"\n" +
+			"             \"\"\n" +
+			"              ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error want does not exist", func(t *testing.T) {
+		// --- When ---
+		err := FilesEqual("testdata/not_existing.txt", "testdata/file.txt")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error reading file:\n" +
+			"   path: testdata/not_existing.txt\n" +
+			"  error: open testdata/not_existing.txt: no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error have does not exist", func(t *testing.T) {
+		// --- When ---
+		err := FilesEqual("testdata/file.txt", "testdata/not_existing.txt")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error reading file:\n" +
+			"   path: testdata/not_existing.txt\n" +
+			"  error: open testdata/not_existing.txt: no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"a.txt": &fstest.MapFile{Data: []byte("id: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a")},
+			"b.txt": &fstest.MapFile{Data: []byte("id: 0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d")},
+		}
+		opts := []Option{WithFS(fsys), WithScrubbers(dump.ScrubUUID)}
+
+		// --- When ---
+		err := FilesEqual("a.txt", "b.txt", opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_FileRegexp(t *testing.T) {
+	t.Run("match string", func(t *testing.T) {
+		// --- When ---
+		err := FileRegexp(`(?m)^jkl`, "testdata/file.txt")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("match regexp", func(t *testing.T) {
+		// --- When ---
+		err := FileRegexp(regexp.MustCompile(`def`), "testdata/file.txt")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not match", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := FileRegexp(`^xyz`, "testdata/file.txt", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file to match regexp:\n" +
+			"   trail: type.field\n" +
+			"    path: testdata/file.txt\n" +
+			"  regexp: ^xyz"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error invalid regexp", func(t *testing.T) {
+		// --- When ---
+		err := FileRegexp(`[a-`, "testdata/file.txt")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid regexp:\n" +
+			"  error: \"error parsing regexp: missing closing ]: `[a-`\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error does not exist", func(t *testing.T) {
+		// --- When ---
+		err := FileRegexp(`abc`, "testdata/not_existing.txt")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error reading file:\n" +
+			"   path: testdata/not_existing.txt\n" +
+			"  error: open testdata/not_existing.txt: no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_FileJSON(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"doc.json": &fstest.MapFile{Data: []byte(`{"a": 1, "b": 2}`)},
+		}
+
+		// --- When ---
+		err := FileJSON(`{"b": 2, "a": 1}`, "doc.json", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"doc.json": &fstest.MapFile{Data: []byte(`{"a": 1, "b": 2}`)},
+		}
+		opts := []Option{WithFS(fsys), WithTrail("type.field")}
+
+		// --- When ---
+		err := FileJSON(`{"a": 1, "b": 3}`, "doc.json", opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON strings to be equal:\n" +
+			"  trail: type.field\n" +
+			"     fs: fstest.MapFS\n" +
+			"   path: doc.json\n" +
+			"   want:\n" +
+			"         {\n" +
+			"           \"a\": 1,\n" +
+			"           \"b\": 3\n" +
+			"         }\n" +
+			"   have:\n" +
+			"         {\n" +
+			"           \"a\": 1,\n" +
+			"           \"b\": 2\n" +
+			"         }\n" +
+			"\n" +
+			"expected JSON values to be equal:\n" +
+			"    trail: type.field\n" +
+			"       fs: fstest.MapFS\n" +
+			"     path: doc.json\n" +
+			"  pointer: /b\n" +
+			"     want: 3\n" +
+			"     have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error invalid JSON", func(t *testing.T) {
+		// --- When ---
+		err := FileJSON(`{}`, "testdata/file.txt")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect unmarshalling error:\n" +
+			"      path: testdata/file.txt\n" +
+			"  argument: have\n" +
+			"     error: invalid character 'a' looking for beginning of value"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error does not exist", func(t *testing.T) {
+		// --- When ---
+		err := FileJSON(`{}`, "testdata/not_existing.json")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error reading file:\n" +
+			"   path: testdata/not_existing.json\n" +
+			"  error: open testdata/not_existing.json: no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_FileLines(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := []string{"abc def ghi", "jkl mno pqr"}
+
+		// --- When ---
+		err := FileLines(want, "testdata/file.txt")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal with trailing new line", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"a.txt": &fstest.MapFile{Data: []byte("a\r\nb\n")}}
+
+		// --- When ---
+		err := FileLines([]string{"a", "b"}, "a.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		want := []string{"abc def ghi", "jkl mno"}
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := FileLines(want, "testdata/file.txt", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file lines to be equal:\n" +
+			"   trail: type.field\n" +
+			"    path: testdata/file.txt\n" +
+			"    line: 2\n" +
+			"    want: \"jkl mno\"\n" +
+			"    have: \"jkl mno pqr\"\n" +
+			"  offset: 7\n" +
+			"    diff:\n" +
+			"          \"jkl mno\"\n" +
+			"          \"jkl mno pqr\"\n" +
+			"                  ^\n" +
+			"   runes: want <none>, have U+0020 ' '"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal empty file", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"a.txt": &fstest.MapFile{}}
+
+		// --- When ---
+		err := FileLines([]string{""}, "a.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file lines to be equal:\n" +
+			"          fs: fstest.MapFS\n" +
+			"        path: a.txt\n" +
+			"  want lines: 1\n" +
+			"  have lines: 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error does not exist", func(t *testing.T) {
+		// --- When ---
+		err := FileLines(nil, "testdata/not_existing.txt")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error reading file:\n" +
+			"   path: testdata/not_existing.txt\n" +
+			"  error: open testdata/not_existing.txt: no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		data := []byte("id: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a\nname: Bob\n")
+		fsys := fstest.MapFS{"file.txt": &fstest.MapFile{Data: data}}
+		want := []string{"id: 0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", "name: Bob"}
+		opts := []Option{WithFS(fsys), WithScrubbers(dump.ScrubUUID)}
+
+		// --- When ---
+		err := FileLines(want, "file.txt", opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, "id: 0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", want[0])
+	})
+}
+
+func Test_FileLineCount(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- When ---
+		err := FileLineCount(2, "testdata/file.txt")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal empty file", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{"a.txt": &fstest.MapFile{}}
+
+		// --- When ---
+		err := FileLineCount(0, "a.txt", WithFS(fsys))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := FileLineCount(3, "testdata/file.txt", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected file line count:\n" +
+			"  trail: type.field\n" +
+			"   path: testdata/file.txt\n" +
+			"   want: 3\n" +
+			"   have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error does not exist", func(t *testing.T) {
+		// --- When ---
+		err := FileLineCount(1, "testdata/not_existing.txt")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error reading file:\n" +
+			"   path: testdata/not_existing.txt\n" +
+			"  error: open testdata/not_existing.txt: no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
 }
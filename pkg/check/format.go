@@ -0,0 +1,391 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// UUID checks "have" is a UUID in the canonical "8-4-4-4-12" hexadecimal
+// form. Returns nil if it is, otherwise it returns an error with a message
+// indicating the rule which failed.
+func UUID(have string, opts ...Option) error {
+	if rule := uuidRule(have); rule != "" {
+		ops := DefaultOptions(opts...)
+		return formatNotice("UUID", have, ops).Append("rule", "%s", rule)
+	}
+	return nil
+}
+
+// UUIDVersion checks "have" is a UUID in the canonical form with the "want"
+// version. Returns nil if it is, otherwise it returns an error with a message
+// indicating the rule which failed or the expected and actual versions.
+func UUIDVersion(want int, have string, opts ...Option) error {
+	if err := UUID(have, opts...); err != nil {
+		return err
+	}
+	ver, _ := strconv.ParseInt(have[14:15], 16, 0)
+	if int(ver) == want {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return notice.New("expected UUID version").
+		Trail(ops.Trail).
+		Have("%q", have).
+		Append("want version", "%d", want).
+		Append("have version", "%d", ver)
+}
+
+// uuidRule returns description of the rule "have" UUID breaks or empty
+// string if it's valid.
+func uuidRule(have string) string {
+	if len(have) != 36 {
+		return "must be 36 characters long"
+	}
+	for i, r := range have {
+		switch i {
+		case 8, 13, 18, 23:
+			if r != '-' {
+				return fmt.Sprintf("expected '-' at offset %d", i)
+			}
+		default:
+			if !isHexRune(r) {
+				return fmt.Sprintf("invalid hex character %q at offset %d", r, i)
+			}
+		}
+	}
+	return ""
+}
+
+// ULID checks "have" is a ULID - 26 characters long string in Crockford's
+// base32 encoding. Returns nil if it is, otherwise it returns an error with a
+// message indicating the rule which failed.
+func ULID(have string, opts ...Option) error {
+	rule := ""
+	switch {
+	case len(have) != 26:
+		rule = "must be 26 characters long"
+
+	case have[0] > '7':
+		rule = "timestamp overflow, first character must be in 0-7 range"
+
+	default:
+		for i, r := range have {
+			if !strings.ContainsRune(ulidAlphabet, r) {
+				rule = fmt.Sprintf(
+					"invalid Crockford's base32 character %q at offset %d",
+					r,
+					i,
+				)
+				break
+			}
+		}
+	}
+	if rule == "" {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return formatNotice("ULID", have, ops).Append("rule", "%s", rule)
+}
+
+// ulidAlphabet is the Crockford's base32 alphabet used by ULIDs.
+const ulidAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZabcdefghjkmnpqrstvwxyz"
+
+// RFC3339 checks "have" is a time in [time.RFC3339] format. Fractional
+// seconds are allowed. Returns nil if it is, otherwise it returns an error
+// with a message indicating the parsing error.
+func RFC3339(have string, opts ...Option) error {
+	if _, err := time.Parse(time.RFC3339, have); err != nil {
+		ops := DefaultOptions(opts...)
+		return formatNotice("RFC3339 time", have, ops).
+			Append("error", "%s", err)
+	}
+	return nil
+}
+
+// Email checks "have" is an e-mail address as parsed by
+// [mail.ParseAddress]. The address must not have a display name or angle
+// brackets. Returns nil if it is, otherwise it returns an error with a
+// message indicating the rule which failed.
+func Email(have string, opts ...Option) error {
+	addr, err := mail.ParseAddress(have)
+	if err != nil {
+		ops := DefaultOptions(opts...)
+		return formatNotice("e-mail address", have, ops).
+			Append("error", "%s", err)
+	}
+	if addr.Address != have {
+		ops := DefaultOptions(opts...)
+		return formatNotice("e-mail address", have, ops).
+			Append("rule", "%s", "must not have display name or angle brackets")
+	}
+	return nil
+}
+
+// URL checks "have" is an absolute URL with a scheme and a host as parsed by
+// [url.Parse]. Returns nil if it is, otherwise it returns an error with a
+// message indicating the rule which failed.
+func URL(have string, opts ...Option) error {
+	return URLScheme(nil, have, opts...)
+}
+
+// URLScheme checks "have" is an absolute URL with a host and one of the
+// "want" schemes. Schemes are compared case-insensitively. When "want" is
+// empty any scheme is allowed. Returns nil if it is, otherwise it returns an
+// error with a message indicating the rule which failed.
+//
+// Example:
+//
+//	check.URLScheme([]string{"http", "https"}, "ftp://example.com")
+func URLScheme(want []string, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	u, err := url.Parse(have)
+	if err != nil {
+		return formatNotice("URL", have, ops).Append("error", "%s", err)
+	}
+	if u.Scheme == "" {
+		return formatNotice("URL", have, ops).
+			Append("rule", "%s", "must have a scheme")
+	}
+	if u.Host == "" {
+		return formatNotice("URL", have, ops).
+			Append("rule", "%s", "must have a host")
+	}
+	if len(want) > 0 && !slices.ContainsFunc(want, func(s string) bool {
+		return strings.EqualFold(s, u.Scheme)
+	}) {
+		return notice.New("expected URL scheme").
+			Trail(ops.Trail).
+			Have("%q", have).
+			Append("want scheme", "%s", strings.Join(want, ", ")).
+			Append("have scheme", "%s", u.Scheme)
+	}
+	return nil
+}
+
+// Semver checks "have" is a version as defined by the Semantic Versioning
+// 2.0.0 specification (without the "v" prefix). Returns nil if it is,
+// otherwise it returns an error with a message indicating the rule which
+// failed.
+func Semver(have string, opts ...Option) error {
+	if rule := semverRule(have); rule != "" {
+		ops := DefaultOptions(opts...)
+		return formatNotice("semantic version", have, ops).
+			Append("rule", "%s", rule)
+	}
+	return nil
+}
+
+// semverRule returns description of the rule "have" semantic version breaks
+// or empty string if it's valid.
+func semverRule(have string) string {
+	core, build, hasBuild := strings.Cut(have, "+")
+	core, pre, hasPre := strings.Cut(core, "-")
+
+	nums := strings.Split(core, ".")
+	if len(nums) != 3 {
+		return "must have MAJOR.MINOR.PATCH version core"
+	}
+	for i, num := range nums {
+		name := [...]string{"major", "minor", "patch"}[i]
+		if num == "" || strings.Trim(num, "0123456789") != "" {
+			return name + " version must be a number"
+		}
+		if len(num) > 1 && num[0] == '0' {
+			return name + " version must not have leading zeros"
+		}
+	}
+	if hasPre {
+		for _, id := range strings.Split(pre, ".") {
+			if rule := semverIDRule(id); rule != "" {
+				return "pre-release " + rule
+			}
+			isNum := strings.Trim(id, "0123456789") == ""
+			if isNum && len(id) > 1 && id[0] == '0' {
+				return "pre-release numeric identifier must not have leading zeros"
+			}
+		}
+	}
+	if hasBuild {
+		for _, id := range strings.Split(build, ".") {
+			if rule := semverIDRule(id); rule != "" {
+				return "build metadata " + rule
+			}
+		}
+	}
+	return ""
+}
+
+// semverIDRule returns description of the rule semantic version pre-release
+// or build metadata identifier breaks or empty string if it's valid.
+func semverIDRule(id string) string {
+	if id == "" {
+		return "identifier must not be empty"
+	}
+	for _, r := range id {
+		isAlpha := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if !isAlpha && !(r >= '0' && r <= '9') && r != '-' {
+			return fmt.Sprintf("identifier has invalid character %q", r)
+		}
+	}
+	return ""
+}
+
+// Base64 checks "have" is a padded standard base64 encoded string as defined
+// in RFC 4648. Returns nil if it is, otherwise it returns an error with a
+// message indicating the decoding error.
+func Base64(have string, opts ...Option) error {
+	return base64Check("base64", base64.StdEncoding, have, opts...)
+}
+
+// Base64URL checks "have" is a URL and filename safe base64 encoded string
+// as defined in RFC 4648. The padding is optional. Returns nil if it is,
+// otherwise it returns an error with a message indicating the decoding
+// error.
+func Base64URL(have string, opts ...Option) error {
+	enc := base64.RawURLEncoding
+	if strings.HasSuffix(have, "=") {
+		enc = base64.URLEncoding
+	}
+	return base64Check("base64url", enc, have, opts...)
+}
+
+// base64Check checks "have" is a non-empty string encoded with "enc".
+func base64Check(
+	name string,
+	enc *base64.Encoding,
+	have string,
+	opts ...Option,
+) error {
+
+	ops := DefaultOptions(opts...)
+	if have == "" {
+		return formatNotice(name, have, ops).
+			Append("rule", "%s", "must not be empty")
+	}
+	if _, err := enc.DecodeString(have); err != nil {
+		return formatNotice(name, have, ops).Append("error", "%s", err)
+	}
+	return nil
+}
+
+// Hex checks "have" is a non-empty hexadecimal encoded string with even
+// number of digits. Returns nil if it is, otherwise it returns an error with
+// a message indicating the decoding error.
+func Hex(have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	if have == "" {
+		return formatNotice("hex", have, ops).
+			Append("rule", "%s", "must not be empty")
+	}
+	if _, err := hex.DecodeString(have); err != nil {
+		return formatNotice("hex", have, ops).Append("error", "%s", err)
+	}
+	return nil
+}
+
+// JWT checks "have" has the structure of a JSON Web Token in compact
+// serialization - three dot separated base64url encoded parts where the
+// header and the payload are JSON objects. The signature is not verified.
+// Returns nil if it is, otherwise it returns an error with a message
+// indicating the rule which failed.
+func JWT(have string, opts ...Option) error {
+	if rule := jwtRule(have); rule != "" {
+		ops := DefaultOptions(opts...)
+		return formatNotice("JWT", have, ops).Append("rule", "%s", rule)
+	}
+	return nil
+}
+
+// jwtRule returns description of the rule "have" JWT breaks or empty string
+// if it's valid.
+func jwtRule(have string) string {
+	parts := strings.Split(have, ".")
+	if len(parts) != 3 {
+		return "must have 3 dot separated parts"
+	}
+	for i, name := range []string{"header", "payload"} {
+		data, err := base64.RawURLEncoding.DecodeString(parts[i])
+		if err != nil {
+			return name + " must be base64url encoded without padding"
+		}
+		var obj map[string]any
+		if err = json.Unmarshal(data, &obj); err != nil || obj == nil {
+			return name + " must be JSON object"
+		}
+	}
+	if _, err := base64.RawURLEncoding.DecodeString(parts[2]); err != nil {
+		return "signature must be base64url encoded without padding"
+	}
+	return ""
+}
+
+// IPv4 checks "have" is an IPv4 address in dotted decimal notation. Returns
+// nil if it is, otherwise it returns an error with a message indicating the
+// rule which failed.
+func IPv4(have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	addr, err := netip.ParseAddr(have)
+	if err != nil {
+		return formatNotice("IPv4 address", have, ops).
+			Append("error", "%s", err)
+	}
+	if !addr.Is4() {
+		return formatNotice("IPv4 address", have, ops).
+			Append("rule", "%s", "must not be IPv6 address")
+	}
+	return nil
+}
+
+// IPv6 checks "have" is an IPv6 address. Returns nil if it is, otherwise it
+// returns an error with a message indicating the rule which failed.
+func IPv6(have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	addr, err := netip.ParseAddr(have)
+	if err != nil {
+		return formatNotice("IPv6 address", have, ops).
+			Append("error", "%s", err)
+	}
+	if !addr.Is6() {
+		return formatNotice("IPv6 address", have, ops).
+			Append("rule", "%s", "must not be IPv4 address")
+	}
+	return nil
+}
+
+// CIDR checks "have" is an IPv4 or IPv6 address prefix in CIDR notation.
+// Returns nil if it is, otherwise it returns an error with a message
+// indicating the parsing error.
+func CIDR(have string, opts ...Option) error {
+	if _, err := netip.ParsePrefix(have); err != nil {
+		ops := DefaultOptions(opts...)
+		return formatNotice("CIDR", have, ops).Append("error", "%s", err)
+	}
+	return nil
+}
+
+// formatNotice returns a new notice for "have" not being a valid "name".
+func formatNotice(name, have string, ops Options) *notice.Notice {
+	return notice.New("expected valid %s", name).
+		Trail(ops.Trail).
+		Have("%q", have)
+}
+
+// isHexRune returns true if the rune is a hexadecimal digit.
+func isHexRune(r rune) bool {
+	return (r >= '0' && r <= '9') || (r >= 'a' && r <= 'f') ||
+		(r >= 'A' && r <= 'F')
+}
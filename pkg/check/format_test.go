@@ -0,0 +1,657 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_UUID(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := UUID("7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4A")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	tt := []struct {
+		testN string
+
+		have string
+		rule string
+	}{
+		{"empty", "", "must be 36 characters long"},
+		{
+			"too short",
+			"7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4",
+			"must be 36 characters long",
+		},
+		{
+			"no hyphen",
+			"7f0c5b6e08c4a-4c7e-9d3a-2b1f0e6d5c4a",
+			"expected '-' at offset 8",
+		},
+		{
+			"not hex",
+			"7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4x",
+			"invalid hex character 'x' at offset 35",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := UUID(tc.have, WithTrail("type.field"))
+
+			// --- Then ---
+			affirm.NotNil(t, err)
+			wMsg := "expected valid UUID:\n" +
+				"  trail: type.field\n" +
+				"   have: \"" + tc.have + "\"\n" +
+				"   rule: " + tc.rule
+			affirm.Equal(t, wMsg, err.Error())
+		})
+	}
+}
+
+func Test_UUIDVersion(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := UUIDVersion(4, "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error invalid", func(t *testing.T) {
+		// --- When ---
+		err := UUIDVersion(4, "abc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid UUID:\n" +
+			"  have: \"abc\"\n" +
+			"  rule: must be 36 characters long"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error version", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := UUIDVersion(7, "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected UUID version:\n" +
+			"         trail: type.field\n" +
+			"          have: \"7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a\"\n" +
+			"  want version: 7\n" +
+			"  have version: 4"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_ULID(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := ULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("valid lower case", func(t *testing.T) {
+		// --- When ---
+		err := ULID("01arz3ndektsv4rrffq69g5fav")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	tt := []struct {
+		testN string
+
+		have string
+		rule string
+	}{
+		{"empty", "", "must be 26 characters long"},
+		{"too long", "01ARZ3NDEKTSV4RRFFQ69G5FAVX", "must be 26 characters long"},
+		{
+			"overflow",
+			"81ARZ3NDEKTSV4RRFFQ69G5FAV",
+			"timestamp overflow, first character must be in 0-7 range",
+		},
+		{
+			"invalid character",
+			"01ARZ3NDEKTSV4RRFFQ69G5FAU",
+			"invalid Crockford's base32 character 'U' at offset 25",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := ULID(tc.have)
+
+			// --- Then ---
+			affirm.NotNil(t, err)
+			wMsg := "expected valid ULID:\n" +
+				"  have: \"" + tc.have + "\"\n" +
+				"  rule: " + tc.rule
+			affirm.Equal(t, wMsg, err.Error())
+		})
+	}
+}
+
+func Test_RFC3339(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := RFC3339("2020-01-02T03:04:05Z")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("valid with fractional seconds and offset", func(t *testing.T) {
+		// --- When ---
+		err := RFC3339("2020-01-02T03:04:05.123+01:00")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := RFC3339("2020-01-02 03:04:05", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid RFC3339 time:\n" +
+			"  trail: type.field\n" +
+			"   have: \"2020-01-02 03:04:05\"\n" +
+			"  error: parsing time \"2020-01-02 03:04:05\" as " +
+			"\"2006-01-02T15:04:05Z07:00\": cannot parse \" 03:04:05\" as \"T\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Email(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := Email("bob.smith+tag@example.com")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Email("bob", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid e-mail address:\n" +
+			"  trail: type.field\n" +
+			"   have: \"bob\"\n" +
+			"  error: mail: missing '@' or angle-addr"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error display name", func(t *testing.T) {
+		// --- When ---
+		err := Email("Bob <bob@example.com>")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid e-mail address:\n" +
+			"  have: \"Bob <bob@example.com>\"\n" +
+			"  rule: must not have display name or angle brackets"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_URL(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := URL("ftp://example.com/path?q=1")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	tt := []struct {
+		testN string
+
+		have string
+		row  string
+	}{
+		{
+			"parse error",
+			"http://a b.com",
+			"  error: parse \"http://a b.com\": invalid character \" \" in host name",
+		},
+		{"no scheme", "example.com/path", "   rule: must have a scheme"},
+		{"no host", "mailto:bob@example.com", "   rule: must have a host"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := URL(tc.have, WithTrail("type.field"))
+
+			// --- Then ---
+			affirm.NotNil(t, err)
+			wMsg := "expected valid URL:\n" +
+				"  trail: type.field\n" +
+				"   have: \"" + tc.have + "\"\n" +
+				tc.row
+			affirm.Equal(t, wMsg, err.Error())
+		})
+	}
+}
+
+func Test_URLScheme(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := URLScheme([]string{"http", "https"}, "HTTPS://example.com")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error invalid", func(t *testing.T) {
+		// --- When ---
+		err := URLScheme([]string{"https"}, "example.com")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid URL:\n" +
+			"  have: \"example.com\"\n" +
+			"  rule: must have a scheme"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error scheme", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := URLScheme([]string{"http", "https"}, "ftp://example.com", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected URL scheme:\n" +
+			"        trail: type.field\n" +
+			"         have: \"ftp://example.com\"\n" +
+			"  want scheme: http, https\n" +
+			"  have scheme: ftp"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Semver(t *testing.T) {
+	valid := []string{
+		"0.0.0",
+		"1.2.3",
+		"10.20.30",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-0.3.7",
+		"1.0.0-x-y-z.--",
+		"1.0.0+20130313144700",
+		"1.0.0-beta+exp.sha.5114f85",
+		"1.0.0+21AF26D3----117B344092BD",
+	}
+
+	for _, have := range valid {
+		t.Run("valid "+have, func(t *testing.T) {
+			// --- When ---
+			err := Semver(have)
+
+			// --- Then ---
+			affirm.Nil(t, err)
+		})
+	}
+
+	tt := []struct {
+		testN string
+
+		have string
+		rule string
+	}{
+		{"empty", "", "must have MAJOR.MINOR.PATCH version core"},
+		{"prefix", "v1.2.3", "major version must be a number"},
+		{"two numbers", "1.2", "must have MAJOR.MINOR.PATCH version core"},
+		{"empty minor", "1..3", "minor version must be a number"},
+		{"leading zero", "1.2.03", "patch version must not have leading zeros"},
+		{
+			"empty pre-release",
+			"1.2.3-",
+			"pre-release identifier must not be empty",
+		},
+		{
+			"pre-release leading zero",
+			"1.2.3-01",
+			"pre-release numeric identifier must not have leading zeros",
+		},
+		{
+			"invalid pre-release",
+			"1.2.3-alpha_1",
+			"pre-release identifier has invalid character '_'",
+		},
+		{
+			"empty build",
+			"1.2.3+abc..def",
+			"build metadata identifier must not be empty",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := Semver(tc.have)
+
+			// --- Then ---
+			affirm.NotNil(t, err)
+			wMsg := "expected valid semantic version:\n" +
+				"  have: \"" + tc.have + "\"\n" +
+				"  rule: " + tc.rule
+			affirm.Equal(t, wMsg, err.Error())
+		})
+	}
+}
+
+func Test_Base64(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := Base64("YWJj+/8=")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Base64("YWJj-_8=", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid base64:\n" +
+			"  trail: type.field\n" +
+			"   have: \"YWJj-_8=\"\n" +
+			"  error: illegal base64 data at input byte 4"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error empty", func(t *testing.T) {
+		// --- When ---
+		err := Base64("")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid base64:\n" +
+			"  have: \"\"\n" +
+			"  rule: must not be empty"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Base64URL(t *testing.T) {
+	t.Run("valid without padding", func(t *testing.T) {
+		// --- When ---
+		err := Base64URL("YWJj-_8")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("valid with padding", func(t *testing.T) {
+		// --- When ---
+		err := Base64URL("YWJj-_8=")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := Base64URL("YWJj+/8")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid base64url:\n" +
+			"   have: \"YWJj+/8\"\n" +
+			"  error: illegal base64 data at input byte 4"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Hex(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := Hex("00aBfF")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	tt := []struct {
+		testN string
+
+		have string
+		row  string
+	}{
+		{"empty", "", "   rule: must not be empty"},
+		{"odd length", "abc", "  error: encoding/hex: odd length hex string"},
+		{"not hex", "zz", "  error: encoding/hex: invalid byte: U+007A 'z'"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := Hex(tc.have, WithTrail("type.field"))
+
+			// --- Then ---
+			affirm.NotNil(t, err)
+			wMsg := "expected valid hex:\n" +
+				"  trail: type.field\n" +
+				"   have: \"" + tc.have + "\"\n" +
+				tc.row
+			affirm.Equal(t, wMsg, err.Error())
+		})
+	}
+}
+
+func Test_JWT(t *testing.T) {
+	// {"alg":"HS256","typ":"JWT"}
+	header := "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"
+	// {"sub":"1234567890"}
+	payload := "eyJzdWIiOiIxMjM0NTY3ODkwIn0"
+	signature := "SflKxwRJSMeKKF2QT4fwpMeJf36POk6yJV_adQssw5c"
+
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := JWT(header + "." + payload + "." + signature)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("valid without signature", func(t *testing.T) {
+		// --- When ---
+		err := JWT(header + "." + payload + ".")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	tt := []struct {
+		testN string
+
+		have string
+		rule string
+	}{
+		{"two parts", header + "." + payload, "must have 3 dot separated parts"},
+		{
+			"header not base64url",
+			"e+." + payload + "." + signature,
+			"header must be base64url encoded without padding",
+		},
+		{
+			"header not JSON object",
+			"WzFd." + payload + "." + signature, // [1]
+			"header must be JSON object",
+		},
+		{
+			"payload not JSON",
+			header + ".YWJj." + signature, // abc
+			"payload must be JSON object",
+		},
+		{
+			"signature not base64url",
+			header + "." + payload + ".a=",
+			"signature must be base64url encoded without padding",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := JWT(tc.have)
+
+			// --- Then ---
+			affirm.NotNil(t, err)
+			wMsg := "expected valid JWT:\n" +
+				"  have: \"" + tc.have + "\"\n" +
+				"  rule: " + tc.rule
+			affirm.Equal(t, wMsg, err.Error())
+		})
+	}
+}
+
+func Test_IPv4(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := IPv4("192.168.0.1")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := IPv4("1.2.3", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid IPv4 address:\n" +
+			"  trail: type.field\n" +
+			"   have: \"1.2.3\"\n" +
+			"  error: ParseAddr(\"1.2.3\"): IPv4 address too short"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error IPv6", func(t *testing.T) {
+		// --- When ---
+		err := IPv4("::ffff:192.168.0.1")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid IPv4 address:\n" +
+			"  have: \"::ffff:192.168.0.1\"\n" +
+			"  rule: must not be IPv6 address"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_IPv6(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := IPv6("2001:db8::1")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := IPv6("2001:db8::g", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid IPv6 address:\n" +
+			"  trail: type.field\n" +
+			"   have: \"2001:db8::g\"\n" +
+			"  error: ParseAddr(\"2001:db8::g\"): each colon-separated " +
+			"field must have at least one digit (at \"g\")"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error IPv4", func(t *testing.T) {
+		// --- When ---
+		err := IPv6("192.168.0.1")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid IPv6 address:\n" +
+			"  have: \"192.168.0.1\"\n" +
+			"  rule: must not be IPv4 address"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_CIDR(t *testing.T) {
+	t.Run("valid IPv4", func(t *testing.T) {
+		// --- When ---
+		err := CIDR("10.0.0.0/8")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("valid IPv6", func(t *testing.T) {
+		// --- When ---
+		err := CIDR("2001:db8::/32")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := CIDR("10.0.0.0/33", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid CIDR:\n" +
+			"  trail: type.field\n" +
+			"   have: \"10.0.0.0/33\"\n" +
+			"  error: netip.ParsePrefix(\"10.0.0.0/33\"): prefix length " +
+			"out of range"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// Image checks "want" and "have" images are equal. Pixels are compared
+// channel by channel in non-alpha-premultiplied RGBA color space. Use
+// [WithImageTolerance] option to allow small differences of channel values,
+// [WithImageMaxDiff] option to allow a number of differing pixels and
+// [WithImageIgnore] option to skip regions of the images. Images with
+// different bounds but the same size are compared pixel by pixel starting
+// from their top left corners.
+//
+// When it fails, it returns an error with a message indicating the number of
+// differing pixels, the first differing pixel and the path to the PNG file
+// highlighting differing pixels in red. The file is written to a new
+// directory in [os.TempDir].
+func Image(want, have image.Image, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	if want == nil || have == nil {
+		return notice.New("expected non-nil images").
+			Trail(ops.Trail).
+			Want("%s", imageSizeString(want)).
+			Have("%s", imageSizeString(have))
+	}
+	wSize, hSize := want.Bounds().Size(), have.Bounds().Size()
+	if wSize != hSize {
+		return notice.New("expected images of the same size").
+			Trail(ops.Trail).
+			Want("%s", imageSizeString(want)).
+			Have("%s", imageSizeString(have))
+	}
+
+	diff := compareImages(want, have, ops)
+	if diff.count <= ops.ImageMaxDiff {
+		return nil
+	}
+	msg := notice.New("expected images to be equal").
+		Trail(ops.Trail).
+		Append("size", "%s", imageSizeString(want)).
+		Append("tolerance", "%d", ops.ImageTolerance).
+		Append("max diff", "%d", ops.ImageMaxDiff).
+		Append("have diff", "%d", diff.count).
+		Append("first diff", "(%d, %d)", diff.first.X, diff.first.Y).
+		Want("%s", colorString(diff.want)).
+		Have("%s", colorString(diff.have))
+	pth, err := writeImageDiff(diff.img)
+	if err != nil {
+		return msg.Append("diff error", "%s", err)
+	}
+	return msg.Append("diff image", "%s", pth)
+}
+
+// FileImage checks PNG images in the files at "wantPNG" and "havePNG" paths
+// are equal. See [Image] for details about the comparison and the options.
+func FileImage(wantPNG, havePNG string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	want, err := ops.readPNG(wantPNG)
+	if err != nil {
+		return err
+	}
+	have, err := ops.readPNG(havePNG)
+	if err != nil {
+		return err
+	}
+	if err = Image(want, have, opts...); err != nil {
+		msg := notice.From(err).
+			Prepend("have path", "%s", ops.Dumper.Scrub(havePNG)).
+			Prepend("want path", "%s", ops.Dumper.Scrub(wantPNG))
+		if ops.FS != nil {
+			_ = msg.Prepend("fs", "%T", ops.FS)
+		}
+		return msg
+	}
+	return nil
+}
+
+// readPNG reads and decodes PNG image from the file at "pth".
+func (ops Options) readPNG(pth string) (image.Image, error) {
+	data, err := ops.readFile(pth)
+	if err != nil {
+		return nil, ops.fsNotice("expected no error reading file", pth).
+			Append("error", "%s", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ops.fsNotice("expected no error decoding PNG", pth).
+			Append("error", "%s", err)
+	}
+	return img, nil
+}
+
+// imageDiff represents the result of comparing two images.
+type imageDiff struct {
+	count int          // Number of differing pixels.
+	first image.Point  // The first differing pixel relative to top left.
+	want  color.NRGBA  // The "want" color of the first differing pixel.
+	have  color.NRGBA  // The "have" color of the first differing pixel.
+	img   *image.NRGBA // Image highlighting differing pixels.
+}
+
+// compareImages compares pixels of the same size images and returns the
+// result of the comparison.
+func compareImages(want, have image.Image, ops Options) imageDiff {
+	wb, hb := want.Bounds(), have.Bounds()
+	diff := imageDiff{img: image.NewNRGBA(image.Rect(0, 0, wb.Dx(), wb.Dy()))}
+	for y := 0; y < wb.Dy(); y++ {
+		for x := 0; x < wb.Dx(); x++ {
+			pt := image.Pt(x, y)
+			wc := color.NRGBAModel.Convert(want.At(wb.Min.X+x, wb.Min.Y+y))
+			hc := color.NRGBAModel.Convert(have.At(hb.Min.X+x, hb.Min.Y+y))
+			wn, hn := wc.(color.NRGBA), hc.(color.NRGBA)
+			if imageIgnored(pt, ops.ImageIgnore) ||
+				colorsClose(wn, hn, ops.ImageTolerance) {
+				diff.img.SetNRGBA(x, y, fadeColor(wn))
+				continue
+			}
+			if diff.count == 0 {
+				diff.first, diff.want, diff.have = pt, wn, hn
+			}
+			diff.count++
+			diff.img.SetNRGBA(x, y, color.NRGBA{R: 255, A: 255})
+		}
+	}
+	return diff
+}
+
+// imageIgnored returns true if the point is in any of the regions.
+func imageIgnored(pt image.Point, regions []image.Rectangle) bool {
+	for _, region := range regions {
+		if pt.In(region) {
+			return true
+		}
+	}
+	return false
+}
+
+// colorsClose returns true if the difference of each channel value is not
+// greater than the tolerance.
+func colorsClose(a, b color.NRGBA, tolerance uint8) bool {
+	return channelClose(a.R, b.R, tolerance) &&
+		channelClose(a.G, b.G, tolerance) &&
+		channelClose(a.B, b.B, tolerance) &&
+		channelClose(a.A, b.A, tolerance)
+}
+
+// channelClose returns true if the difference of channel values is not
+// greater than the tolerance.
+func channelClose(a, b, tolerance uint8) bool {
+	if a > b {
+		return a-b <= tolerance
+	}
+	return b-a <= tolerance
+}
+
+// fadeColor returns a light gray color with luminance of the given color,
+// used to draw matching pixels in the diff image.
+func fadeColor(c color.NRGBA) color.NRGBA {
+	gray := color.GrayModel.Convert(c).(color.Gray)
+	v := 192 + gray.Y/4
+	return color.NRGBA{R: v, G: v, B: v, A: 255}
+}
+
+// colorString returns string representation of the color.
+func colorString(c color.NRGBA) string {
+	return fmt.Sprintf("rgba(%d, %d, %d, %d)", c.R, c.G, c.B, c.A)
+}
+
+// imageSizeString returns string representation of the image size.
+func imageSizeString(img image.Image) string {
+	if img == nil {
+		return "<nil>"
+	}
+	size := img.Bounds().Size()
+	return fmt.Sprintf("%dx%d", size.X, size.Y)
+}
+
+// writeImageDiff writes the image as "diff.png" file to a new temporary
+// directory and returns its path.
+func writeImageDiff(img image.Image) (string, error) {
+	dir, err := os.MkdirTemp("", "image-diff-*")
+	if err != nil {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	if err = png.Encode(buf, img); err != nil {
+		return "", err
+	}
+	pth := filepath.Join(dir, "diff.png")
+	if err = os.WriteFile(pth, buf.Bytes(), 0o644); err != nil {
+		return "", err
+	}
+	return pth, nil
+}
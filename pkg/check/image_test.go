@@ -0,0 +1,439 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// newImage returns new image of given size filled with the color.
+func newImage(w, h int, c color.NRGBA) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// encodePNG returns the image encoded as PNG.
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// writePNG writes the image as PNG file to the test temporary directory and
+// returns its path.
+func writePNG(t *testing.T, name string, img image.Image) string {
+	t.Helper()
+	pth := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(pth, encodePNG(t, img), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return pth
+}
+
+// diffImage returns the path to the diff image from the error and removes
+// the "diff image" row from the notice. The diff image directory is removed
+// at the end of the test.
+func diffImage(t *testing.T, err error) string {
+	t.Helper()
+	msg := notice.From(err)
+	pth := msg.Rows["diff image"]
+	_ = msg.Remove("diff image")
+	if pth != "" {
+		t.Cleanup(func() { _ = os.RemoveAll(filepath.Dir(pth)) })
+	}
+	return pth
+}
+
+func Test_Image(t *testing.T) {
+	red := color.NRGBA{R: 255, A: 255}
+	blue := color.NRGBA{B: 255, A: 255}
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(3, 2, red)
+		have := newImage(3, 2, red)
+
+		// --- When ---
+		err := Image(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal different bounds", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(3, 2, red)
+		have := newImage(5, 4, red).SubImage(image.Rect(1, 1, 4, 3))
+
+		// --- When ---
+		err := Image(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal different color models", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(1, 1, color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+		have := image.NewGray(image.Rect(0, 0, 1, 1))
+		have.SetGray(0, 0, color.Gray{Y: 255})
+
+		// --- When ---
+		err := Image(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error not equal", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(3, 2, red)
+		have := newImage(3, 2, red)
+		have.SetNRGBA(1, 0, blue)
+		have.SetNRGBA(2, 1, blue)
+
+		// --- When ---
+		err := Image(want, have, WithTrail("type.field"))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		pth := diffImage(t, err)
+		wMsg := "expected images to be equal:\n" +
+			"       trail: type.field\n" +
+			"        size: 3x2\n" +
+			"   tolerance: 0\n" +
+			"    max diff: 0\n" +
+			"   have diff: 2\n" +
+			"  first diff: (1, 0)\n" +
+			"        want: rgba(255, 0, 0, 255)\n" +
+			"        have: rgba(0, 0, 255, 255)"
+		affirm.Equal(t, wMsg, err.Error())
+
+		data, err := os.ReadFile(pth)
+		affirm.Nil(t, err)
+		diff, err := png.Decode(bytes.NewReader(data))
+		affirm.Nil(t, err)
+		at := func(x, y int) color.NRGBA {
+			return color.NRGBAModel.Convert(diff.At(x, y)).(color.NRGBA)
+		}
+		affirm.Equal(t, image.Rect(0, 0, 3, 2), diff.Bounds())
+		affirm.Equal(t, color.NRGBA{R: 255, A: 255}, at(1, 0))
+		affirm.Equal(t, color.NRGBA{R: 255, A: 255}, at(2, 1))
+		affirm.Equal(t, fadeColor(red), at(0, 0))
+	})
+
+	t.Run("error not equal different bounds", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(2, 2, red)
+		img := newImage(4, 4, red)
+		img.SetNRGBA(2, 2, blue)
+		have := img.SubImage(image.Rect(1, 1, 3, 3))
+
+		// --- When ---
+		err := Image(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		_ = diffImage(t, err)
+		wMsg := "expected images to be equal:\n" +
+			"        size: 2x2\n" +
+			"   tolerance: 0\n" +
+			"    max diff: 0\n" +
+			"   have diff: 1\n" +
+			"  first diff: (1, 1)\n" +
+			"        want: rgba(255, 0, 0, 255)\n" +
+			"        have: rgba(0, 0, 255, 255)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("within tolerance", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(2, 2, color.NRGBA{R: 100, G: 100, B: 100, A: 255})
+		have := newImage(2, 2, color.NRGBA{R: 103, G: 97, B: 100, A: 252})
+
+		// --- When ---
+		err := Image(want, have, WithImageTolerance(3))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error above tolerance", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(2, 2, color.NRGBA{R: 100, G: 100, B: 100, A: 255})
+		have := newImage(2, 2, color.NRGBA{R: 100, G: 104, B: 100, A: 255})
+
+		// --- When ---
+		err := Image(want, have, WithImageTolerance(3))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		_ = diffImage(t, err)
+		wMsg := "expected images to be equal:\n" +
+			"        size: 2x2\n" +
+			"   tolerance: 3\n" +
+			"    max diff: 0\n" +
+			"   have diff: 4\n" +
+			"  first diff: (0, 0)\n" +
+			"        want: rgba(100, 100, 100, 255)\n" +
+			"        have: rgba(100, 104, 100, 255)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("within max diff", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(3, 3, red)
+		have := newImage(3, 3, red)
+		have.SetNRGBA(0, 0, blue)
+		have.SetNRGBA(2, 2, blue)
+
+		// --- When ---
+		err := Image(want, have, WithImageMaxDiff(2))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error above max diff", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(3, 3, red)
+		have := newImage(3, 3, red)
+		have.SetNRGBA(0, 0, blue)
+		have.SetNRGBA(1, 1, blue)
+		have.SetNRGBA(2, 2, blue)
+
+		// --- When ---
+		err := Image(want, have, WithImageMaxDiff(2))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		_ = diffImage(t, err)
+		wMsg := "expected images to be equal:\n" +
+			"        size: 3x3\n" +
+			"   tolerance: 0\n" +
+			"    max diff: 2\n" +
+			"   have diff: 3\n" +
+			"  first diff: (0, 0)\n" +
+			"        want: rgba(255, 0, 0, 255)\n" +
+			"        have: rgba(0, 0, 255, 255)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("ignore regions", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(4, 4, red)
+		have := newImage(4, 4, red)
+		have.SetNRGBA(0, 0, blue)
+		have.SetNRGBA(1, 1, blue)
+		have.SetNRGBA(3, 3, blue)
+		opt := WithImageIgnore(image.Rect(0, 0, 2, 2), image.Rect(3, 3, 4, 4))
+
+		// --- When ---
+		err := Image(want, have, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error outside ignore regions", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(4, 4, red)
+		have := newImage(4, 4, red)
+		have.SetNRGBA(0, 0, blue)
+		have.SetNRGBA(2, 2, blue)
+		opt := WithImageIgnore(image.Rect(0, 0, 2, 2))
+
+		// --- When ---
+		err := Image(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		_ = diffImage(t, err)
+		wMsg := "expected images to be equal:\n" +
+			"        size: 4x4\n" +
+			"   tolerance: 0\n" +
+			"    max diff: 0\n" +
+			"   have diff: 1\n" +
+			"  first diff: (2, 2)\n" +
+			"        want: rgba(255, 0, 0, 255)\n" +
+			"        have: rgba(0, 0, 255, 255)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error different size", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(3, 2, red)
+		have := newImage(2, 3, red)
+
+		// --- When ---
+		err := Image(want, have, WithTrail("type.field"))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected images of the same size:\n" +
+			"  trail: type.field\n" +
+			"   want: 3x2\n" +
+			"   have: 2x3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error nil image", func(t *testing.T) {
+		// --- Given ---
+		want := newImage(3, 2, red)
+
+		// --- When ---
+		err := Image(want, nil)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected non-nil images:\n" +
+			"  want: 3x2\n" +
+			"  have: <nil>"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_FileImage(t *testing.T) {
+	red := color.NRGBA{R: 255, A: 255}
+	blue := color.NRGBA{B: 255, A: 255}
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := writePNG(t, "want.png", newImage(3, 2, red))
+		have := writePNG(t, "have.png", newImage(3, 2, red))
+
+		// --- When ---
+		err := FileImage(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal with options", func(t *testing.T) {
+		// --- Given ---
+		img := newImage(3, 2, red)
+		img.SetNRGBA(0, 0, blue)
+		want := writePNG(t, "want.png", newImage(3, 2, red))
+		have := writePNG(t, "have.png", img)
+
+		// --- When ---
+		err := FileImage(want, have, WithImageMaxDiff(1))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error not equal", func(t *testing.T) {
+		// --- Given ---
+		img := newImage(3, 2, red)
+		img.SetNRGBA(2, 1, blue)
+		fsys := fstest.MapFS{
+			"want.png": &fstest.MapFile{Data: encodePNG(t, newImage(3, 2, red))},
+			"have.png": &fstest.MapFile{Data: encodePNG(t, img)},
+		}
+		opts := []Option{WithFS(fsys), WithTrail("type.field")}
+
+		// --- When ---
+		err := FileImage("want.png", "have.png", opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		pth := diffImage(t, err)
+		affirm.Equal(t, "diff.png", filepath.Base(pth))
+		wMsg := "expected images to be equal:\n" +
+			"       trail: type.field\n" +
+			"          fs: fstest.MapFS\n" +
+			"   want path: want.png\n" +
+			"   have path: have.png\n" +
+			"        size: 3x2\n" +
+			"   tolerance: 0\n" +
+			"    max diff: 0\n" +
+			"   have diff: 1\n" +
+			"  first diff: (2, 1)\n" +
+			"        want: rgba(255, 0, 0, 255)\n" +
+			"        have: rgba(0, 0, 255, 255)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error different size", func(t *testing.T) {
+		// --- Given ---
+		want := writePNG(t, "want.png", newImage(3, 2, red))
+		have := writePNG(t, "have.png", newImage(2, 2, red))
+
+		// --- When ---
+		err := FileImage(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected images of the same size:\n" +
+			"  want path: " + want + "\n" +
+			"  have path: " + have + "\n" +
+			"       want: 3x2\n" +
+			"       have: 2x2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error want does not exist", func(t *testing.T) {
+		// --- Given ---
+		have := writePNG(t, "have.png", newImage(3, 2, red))
+
+		// --- When ---
+		err := FileImage("testdata/not_existing.png", have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error reading file:\n" +
+			"   path: testdata/not_existing.png\n" +
+			"  error: open testdata/not_existing.png: no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error have does not exist", func(t *testing.T) {
+		// --- Given ---
+		want := writePNG(t, "want.png", newImage(3, 2, red))
+
+		// --- When ---
+		err := FileImage(want, "testdata/not_existing.png")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error reading file:\n" +
+			"   path: testdata/not_existing.png\n" +
+			"  error: open testdata/not_existing.png: no such file or directory"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error not a PNG", func(t *testing.T) {
+		// --- Given ---
+		have := writePNG(t, "have.png", newImage(3, 2, red))
+
+		// --- When ---
+		err := FileImage("testdata/file.txt", have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected no error decoding PNG:\n" +
+			"   path: testdata/file.txt\n" +
+			"  error: png: invalid format: not a PNG file"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
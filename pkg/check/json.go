@@ -4,42 +4,522 @@
 package check
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ctx42/testing/pkg/notice"
 )
 
-// JSON checks that two JSON strings are equivalent. Returns nil if they are,
-// otherwise it returns an error with a message indicating the expected and
-// actual values.
+// Placeholders which may be used in place of volatile values in the expected
+// JSON documents passed to [JSONPath] and [JSONSubset].
+const (
+	// JSONAny matches any JSON value.
+	JSONAny = "<<ANY>>"
+
+	// JSONUUID matches JSON string with UUID in canonical form.
+	JSONUUID = "<<UUID>>"
+
+	// JSONRFC3339 matches JSON string with time in [time.RFC3339] format.
+	JSONRFC3339 = "<<RFC3339>>"
+)
+
+// JSON checks that two JSON strings are equivalent. Numbers are compared
+// using their exact decimal representation, so large integers do not lose
+// precision. Use [WithUnorderedArrays] option to compare arrays regardless
+// of their elements order. Returns nil if they are, otherwise it returns an
+// error with a message indicating the expected and actual documents followed
+// by the differences, each with the JSON pointer to the differing value.
 //
 // Example:
 //
 //	check.JSON(`{"hello": "world"}`, `{"foo": "bar"}`)
 func JSON(want, have string, opts ...Option) error {
-	var wantItf, haveItf any
-
 	ops := DefaultOptions(opts...)
-	if err := json.Unmarshal([]byte(want), &wantItf); err != nil {
+	want, have = ops.Dumper.Scrub(want), ops.Dumper.Scrub(have)
+	wantItf, err := jsonDecode(want)
+	if err != nil {
 		return notice.New("did not expect unmarshalling error").
 			Trail(ops.Trail).
 			Append("argument", "want").
 			Append("error", "%s", err)
 	}
-	if err := json.Unmarshal([]byte(have), &haveItf); err != nil {
+	haveItf, err := jsonDecode(have)
+	if err != nil {
 		return notice.New("did not expect unmarshalling error").
 			Trail(ops.Trail).
 			Append("argument", "have").
 			Append("error", "%s", err)
 	}
 
-	if err := Equal(wantItf, haveItf, WithOptions(ops)); err != nil {
-		w, _ := json.Marshal(wantItf) // nolint:errchkjson
-		h, _ := json.Marshal(haveItf) // nolint:errchkjson
-		return notice.New("expected JSON strings to be equal").
+	mch := jsonMatcher{
+		unordered: ops.UnorderedArrays,
+		pointer:   true,
+		root:      ops.Trail,
+	}
+	ers := mch.match(wantItf, haveItf, "")
+	if len(ers) == 0 {
+		return nil
+	}
+	msg := notice.New("expected JSON strings to be equal").
+		Trail(ops.Trail).
+		Want("%s", jsonPretty(wantItf)).
+		Have("%s", jsonPretty(haveItf))
+
+	// The difference at the document root is already described.
+	var diff *notice.Notice
+	if len(ers) == 1 && errors.As(ers[0], &diff) {
+		if _, ok := diff.Rows["pointer"]; !ok {
+			return msg
+		}
+	}
+	return wrap(errors.Join(append([]error{msg}, ers...)...))
+}
+
+// JSONPath checks the value at JSON "path" in the "doc" document is equal to
+// "want". The "want" value is compared after it is marshaled to JSON, so
+// numbers of any type, maps, slices and structs may be used. The [JSONAny],
+// [JSONUUID] and [JSONRFC3339] placeholders may be used in place of volatile
+// values. Returns nil if the value is equal, otherwise it returns an error
+// with a message indicating the JSON path and the expected and actual values.
+//
+// The path must start with "$" and supports ".key", "[\"key\"]" and "[N]"
+// selectors.
+//
+// Example:
+//
+//	check.JSONPath(doc, "$.items[0].id", 42)
+func JSONPath(doc, path string, want any, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	sel, err := parseJSONPath(path)
+	if err != nil {
+		return notice.New("invalid JSON path").
+			Trail(ops.Trail).
+			Append("path", "%s", path).
+			Append("error", "%s", err)
+	}
+	wData, err := json.Marshal(want)
+	if err != nil {
+		return notice.New("did not expect marshaling error").
+			Trail(ops.Trail).
+			Append("argument", "want").
+			Append("error", "%s", err)
+	}
+	wantItf, _ := jsonDecode(ops.Dumper.Scrub(string(wData)))
+	haveItf, err := jsonDecode(ops.Dumper.Scrub(doc))
+	if err != nil {
+		return notice.New("did not expect unmarshalling error").
+			Trail(ops.Trail).
+			Append("argument", "doc").
+			Append("error", "%s", err)
+	}
+
+	root := ops.Trail
+	if root == "" {
+		root = "$"
+	}
+	trail := root
+	for _, s := range sel {
+		next, ok := s.find(haveItf)
+		trail = s.trail(trail)
+		if !ok {
+			return notice.New("expected JSON path to exist").
+				Trail(ops.Trail).
+				Append("path", "%s", path).
+				Append("missing", "%s", trail)
+		}
+		haveItf = next
+	}
+	mch := jsonMatcher{unordered: ops.UnorderedArrays, placeholders: true}
+	return wrap(errors.Join(mch.match(wantItf, haveItf, trail)...))
+}
+
+// JSONSubset checks the "want" JSON document is a subset of the "have" JSON
+// document. Objects in "want" may have only some of the keys present in
+// "have", arrays must have the same length and their elements are compared
+// the same way. The [JSONAny], [JSONUUID] and [JSONRFC3339] placeholders may
+// be used in place of volatile values. Returns nil if "want" is a subset of
+// "have", otherwise it returns an error with a message for each difference
+// with a JSON path trail.
+//
+// Example:
+//
+//	check.JSONSubset(`{"id": "<<UUID>>"}`, `{"id": "...", "name": "Bob"}`)
+func JSONSubset(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	want, have = ops.Dumper.Scrub(want), ops.Dumper.Scrub(have)
+	wantItf, err := jsonDecode(want)
+	if err != nil {
+		return notice.New("did not expect unmarshalling error").
 			Trail(ops.Trail).
-			Want("%v", string(w)).
-			Have("%v", string(h))
+			Append("argument", "want").
+			Append("error", "%s", err)
+	}
+	haveItf, err := jsonDecode(have)
+	if err != nil {
+		return notice.New("did not expect unmarshalling error").
+			Trail(ops.Trail).
+			Append("argument", "have").
+			Append("error", "%s", err)
+	}
+	root := ops.Trail
+	if root == "" {
+		root = "$"
+	}
+	mch := jsonMatcher{
+		subset:       true,
+		unordered:    ops.UnorderedArrays,
+		placeholders: true,
+	}
+	return wrap(errors.Join(mch.match(wantItf, haveItf, root)...))
+}
+
+// jsonMatcher compares decoded JSON values.
+type jsonMatcher struct {
+	subset       bool   // Objects in "have" may have keys not in "want".
+	unordered    bool   // Compare arrays regardless of elements order.
+	placeholders bool   // Match placeholders used in "want".
+	pointer      bool   // Report JSON pointers in their own row.
+	root         string // Trail of the documents when pointer is set.
+}
+
+// match compares decoded JSON values and returns a notice for each
+// difference.
+//
+// nolint: cyclop
+func (mch jsonMatcher) match(want, have any, trail string) []error {
+	str, ok := want.(string)
+	if ok && mch.placeholders && isJSONPlaceholder(str) {
+		if matchJSONPlaceholder(str, have) {
+			return nil
+		}
+		msg := mch.notice("expected JSON value to match placeholder", trail).
+			Want("%s", str).
+			Have("%s", jsonString(have))
+		return []error{msg}
+	}
+
+	switch w := want.(type) {
+	case map[string]any:
+		h, ok := have.(map[string]any)
+		if !ok {
+			break
+		}
+		var ers []error
+		for _, key := range sortedKeys(w) {
+			kTrail := mch.keyTrail(trail, key)
+			hVal, exist := h[key]
+			if !exist {
+				msg := mch.notice("expected JSON object to have key", kTrail).
+					Want("%s", jsonString(w[key]))
+				ers = append(ers, msg)
+				continue
+			}
+			ers = append(ers, mch.match(w[key], hVal, kTrail)...)
+		}
+		if mch.subset {
+			return ers
+		}
+		for _, key := range sortedKeys(h) {
+			if _, exist := w[key]; !exist {
+				kTrail := mch.keyTrail(trail, key)
+				msg := mch.notice("unexpected JSON object key", kTrail).
+					Have("%s", jsonString(h[key]))
+				ers = append(ers, msg)
+			}
+		}
+		return ers
+
+	case []any:
+		h, ok := have.([]any)
+		if !ok {
+			break
+		}
+		if mch.unordered {
+			return mch.matchUnordered(w, h, trail)
+		}
+		if len(w) != len(h) {
+			msg := mch.notice("expected JSON array length", trail).
+				Want("%d", len(w)).
+				Have("%d", len(h))
+			return []error{msg}
+		}
+		var ers []error
+		for i := range w {
+			ers = append(ers, mch.match(w[i], h[i], mch.idxTrail(trail, i))...)
+		}
+		return ers
+
+	case json.Number:
+		if h, ok := have.(json.Number); ok && jsonNumberEqual(w, h) {
+			return nil
+		}
+
+	default:
+		if want == have {
+			return nil
+		}
+	}
+
+	msg := mch.notice("expected JSON values to be equal", trail).
+		Want("%s", jsonString(want)).
+		Have("%s", jsonString(have))
+	return []error{msg}
+}
+
+// matchUnordered compares JSON arrays regardless of elements order. Each
+// "have" element is matched at most once.
+func (mch jsonMatcher) matchUnordered(want, have []any, trail string) []error {
+	var ers []error
+	used := make([]bool, len(have))
+	for i, wVal := range want {
+		found := false
+		for j, hVal := range have {
+			if !used[j] && len(mch.match(wVal, hVal, trail)) == 0 {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			iTrail := mch.idxTrail(trail, i)
+			msg := mch.notice("expected JSON array to have element", iTrail).
+				Want("%s", jsonString(wVal))
+			ers = append(ers, msg)
+		}
+	}
+	for j, hVal := range have {
+		if !used[j] {
+			iTrail := mch.idxTrail(trail, j)
+			msg := mch.notice("unexpected JSON array element", iTrail).
+				Have("%s", jsonString(hVal))
+			ers = append(ers, msg)
+		}
+	}
+	return ers
+}
+
+// notice returns a new notice with the given header for the difference at
+// the trail. In pointer mode the trail is a JSON pointer reported in its own
+// row, so it is not mixed with the trail of the compared documents.
+func (mch jsonMatcher) notice(header, trail string) *notice.Notice {
+	if !mch.pointer {
+		return notice.New(header).Trail(trail)
+	}
+	msg := notice.New(header).Trail(mch.root)
+	if trail != "" {
+		msg = msg.Append("pointer", "%s", trail)
+	}
+	return msg
+}
+
+// keyTrail returns trail for the object key.
+func (mch jsonMatcher) keyTrail(trail, key string) string {
+	if mch.pointer {
+		return trail + "/" + jsonPointerEscape(key)
+	}
+	return jsonKeyTrail(trail, key)
+}
+
+// idxTrail returns trail for the array index.
+func (mch jsonMatcher) idxTrail(trail string, idx int) string {
+	if mch.pointer {
+		return trail + "/" + strconv.Itoa(idx)
+	}
+	return trail + "[" + strconv.Itoa(idx) + "]"
+}
+
+// jsonDecode decodes JSON document using [json.Number] for numbers.
+func jsonDecode(doc string) (any, error) {
+	var val any
+	dec := json.NewDecoder(strings.NewReader(doc))
+	dec.UseNumber()
+	if err := dec.Decode(&val); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("invalid data after top-level value")
+	}
+	return val, nil
+}
+
+// jsonNumberEqual returns true if both numbers represent the same value.
+func jsonNumberEqual(want, have json.Number) bool {
+	if want == have {
+		return true
+	}
+	w, wOK := new(big.Rat).SetString(want.String())
+	h, hOK := new(big.Rat).SetString(have.String())
+	return wOK && hOK && w.Cmp(h) == 0
+}
+
+// jsonPointerEscape escapes object key to be used in JSON pointer as
+// described in RFC 6901.
+func jsonPointerEscape(key string) string {
+	key = strings.ReplaceAll(key, "~", "~0")
+	return strings.ReplaceAll(key, "/", "~1")
+}
+
+// isJSONPlaceholder returns true if "str" is one of the supported
+// placeholders.
+func isJSONPlaceholder(str string) bool {
+	return str == JSONAny || str == JSONUUID || str == JSONRFC3339
+}
+
+// matchJSONPlaceholder returns true if "have" matches the placeholder.
+func matchJSONPlaceholder(placeholder string, have any) bool {
+	if placeholder == JSONAny {
+		return true
+	}
+	str, ok := have.(string)
+	if !ok {
+		return false
+	}
+	switch placeholder {
+	case JSONUUID:
+		return uuidRule(str) == ""
+	default:
+		_, err := time.Parse(time.RFC3339, str)
+		return err == nil
+	}
+}
+
+// jsonString returns compact JSON representation of the decoded value.
+func jsonString(val any) string { return jsonEncode(val, "") }
+
+// jsonPretty returns indented JSON representation of the decoded value with
+// object keys sorted.
+func jsonPretty(val any) string { return jsonEncode(val, "  ") }
+
+// jsonEncode returns JSON representation of the decoded value without
+// escaping HTML characters.
+func jsonEncode(val any, indent string) string {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", indent)
+	_ = enc.Encode(val) // nolint:errchkjson
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// jsonKeyTrail returns JSON path trail for the object key. Keys which are not
+// valid identifiers use the bracket notation.
+//
+// Example trails:
+//
+//	$.key
+//	$["my key"]
+func jsonKeyTrail(trail, key string) string {
+	if isJSONIdent(key) {
+		return trail + "." + key
+	}
+	return trail + "[" + strconv.Quote(key) + "]"
+}
+
+// isJSONIdent returns true if "key" may be used in JSON path dot notation.
+func isJSONIdent(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i, r := range key {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && r >= '0' && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// jsonSelector represents single JSON path selector - an object key or an
+// array index.
+type jsonSelector struct {
+	key   string // Object key.
+	idx   int    // Array index.
+	isIdx bool   // Set when selector is an array index.
+}
+
+// find returns value selected from unmarshalled JSON value. Returns false if
+// the value does not exist.
+func (sel jsonSelector) find(val any) (any, bool) {
+	if sel.isIdx {
+		arr, ok := val.([]any)
+		if !ok || sel.idx >= len(arr) {
+			return nil, false
+		}
+		return arr[sel.idx], true
+	}
+	obj, ok := val.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	next, ok := obj[sel.key]
+	return next, ok
+}
+
+// trail returns trail with the selector appended.
+func (sel jsonSelector) trail(trail string) string {
+	if sel.isIdx {
+		return trail + "[" + strconv.Itoa(sel.idx) + "]"
+	}
+	return jsonKeyTrail(trail, sel.key)
+}
+
+// parseJSONPath parses JSON path to a list of selectors.
+func parseJSONPath(path string) ([]jsonSelector, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, errors.New("path must start with $")
+	}
+	var sel []jsonSelector
+	rest := path[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end == -1 {
+				end = len(rest) - 1
+			}
+			key := rest[1 : end+1]
+			if key == "" {
+				return nil, errors.New("empty key")
+			}
+			sel = append(sel, jsonSelector{key: key})
+			rest = rest[end+1:]
+
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if strings.HasPrefix(rest, `["`) {
+				if end = strings.Index(rest, `"]`); end != -1 {
+					end++
+				}
+			}
+			if end == -1 {
+				return nil, errors.New("missing closing bracket")
+			}
+			inner := rest[1:end]
+			if len(inner) > 1 && inner[0] == '"' {
+				key, err := strconv.Unquote(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid key %s", inner)
+				}
+				sel = append(sel, jsonSelector{key: key})
+			} else if idx, err := strconv.Atoi(inner); err == nil && idx >= 0 {
+				sel = append(sel, jsonSelector{idx: idx, isIdx: true})
+			} else {
+				return nil, fmt.Errorf("invalid index %s", inner)
+			}
+			rest = rest[end+1:]
+
+		default:
+			return nil, fmt.Errorf("unexpected character %q", rest[0])
+		}
 	}
-	return nil
+	return sel, nil
 }
@@ -4,9 +4,12 @@
 package check
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/dump"
 )
 
 func Test_JSON(t *testing.T) {
@@ -35,8 +38,181 @@ func Test_JSON(t *testing.T) {
 		affirm.NotNil(t, err)
 		wMsg := "expected JSON strings to be equal:\n" +
 			"  trail: type.field\n" +
-			"   want: {\"hello\":\"world\"}\n" +
-			"   have: {\"hello\":\"ms\"}"
+			"   want:\n" +
+			"         {\n" +
+			"           \"hello\": \"world\"\n" +
+			"         }\n" +
+			"   have:\n" +
+			"         {\n" +
+			"           \"hello\": \"ms\"\n" +
+			"         }\n" +
+			"\n" +
+			"expected JSON values to be equal:\n" +
+			"    trail: type.field\n" +
+			"  pointer: /hello\n" +
+			"     want: \"world\"\n" +
+			"     have: \"ms\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("equal - numbers", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": 1, "b": 1.50, "c": 1e2}`
+		have := `{"a": 1.0, "b": 1.5, "c": 100}`
+
+		// --- When ---
+		err := JSON(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal - large integers", func(t *testing.T) {
+		// --- Given ---
+		want := `{"id": 9007199254740993}`
+		have := `{"id": 9007199254740992}`
+
+		// --- When ---
+		err := JSON(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON strings to be equal:\n" +
+			"  want:\n" +
+			"        {\n" +
+			"          \"id\": 9007199254740993\n" +
+			"        }\n" +
+			"  have:\n" +
+			"        {\n" +
+			"          \"id\": 9007199254740992\n" +
+			"        }\n" +
+			"\n" +
+			"expected JSON values to be equal:\n" +
+			"  pointer: /id\n" +
+			"     want: 9007199254740993\n" +
+			"     have: 9007199254740992"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - at document root", func(t *testing.T) {
+		// --- When ---
+		err := JSON(`"<a>"`, `["<a>"]`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON strings to be equal:\n" +
+			"  want: \"<a>\"\n" +
+			"  have:\n" +
+			"        [\n" +
+			"          \"<a>\"\n" +
+			"        ]"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - multiple differences", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a/b": [1, 2], "c~d": {"e": true}, "f": 1}`
+		have := `{"a/b": [1], "c~d": {"e": false}, "g": 1}`
+
+		// --- When ---
+		err := JSON(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON array length:\n" +
+			"  pointer: /a~1b\n" +
+			"     want: 2\n" +
+			"     have: 1\n" +
+			"\n" +
+			"expected JSON values to be equal:\n" +
+			"  pointer: /c~0d/e\n" +
+			"     want: true\n" +
+			"     have: false\n" +
+			"\n" +
+			"expected JSON object to have key:\n" +
+			"  pointer: /f\n" +
+			"     want: 1\n" +
+			"\n" +
+			"unexpected JSON object key:\n" +
+			"  pointer: /g\n" +
+			"     have: 1"
+		affirm.True(t, strings.HasSuffix(err.Error(), wMsg))
+	})
+
+	t.Run("equal - unordered arrays", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": [1, {"b": [3, 2]}, 1]}`
+		have := `{"a": [{"b": [2, 3]}, 1, 1]}`
+
+		// --- When ---
+		err := JSON(want, have, WithUnorderedArrays)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal - unordered arrays", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": [1, 2, 2]}`
+		have := `{"a": [2, 3, 1]}`
+
+		// --- When ---
+		err := JSON(want, have, WithUnorderedArrays)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON array to have element:\n" +
+			"  pointer: /a/2\n" +
+			"     want: 2\n" +
+			"\n" +
+			"unexpected JSON array element:\n" +
+			"  pointer: /a/1\n" +
+			"     have: 3"
+		affirm.True(t, strings.HasSuffix(err.Error(), wMsg))
+	})
+
+	t.Run("not equal - pointer with trail", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": [{"b": 1}], "c": [1, 2]}`
+		have := `{"a": [{"b": 2}], "c": [3, 1]}`
+		opts := []Option{WithTrail("Resp.Body"), WithUnorderedArrays}
+
+		// --- When ---
+		err := JSON(want, have, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON array to have element:\n" +
+			"    trail: Resp.Body\n" +
+			"  pointer: /a/0\n" +
+			"     want: {\"b\":1}\n" +
+			"\n" +
+			"unexpected JSON array element:\n" +
+			"    trail: Resp.Body\n" +
+			"  pointer: /a/0\n" +
+			"     have: {\"b\":2}\n" +
+			"\n" +
+			"expected JSON array to have element:\n" +
+			"    trail: Resp.Body\n" +
+			"  pointer: /c/1\n" +
+			"     want: 2\n" +
+			"\n" +
+			"unexpected JSON array element:\n" +
+			"    trail: Resp.Body\n" +
+			"  pointer: /c/0\n" +
+			"     have: 3"
+		affirm.True(t, strings.HasSuffix(err.Error(), wMsg))
+	})
+
+	t.Run("invalid data after top-level value", func(t *testing.T) {
+		// --- When ---
+		err := JSON(`{} []`, `{}`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect unmarshalling error:\n" +
+			"  argument: want\n" +
+			"     error: invalid data after top-level value"
 		affirm.Equal(t, wMsg, err.Error())
 	})
 
@@ -77,4 +253,448 @@ func Test_JSON(t *testing.T) {
 			"object key string"
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		want := `{"id": "<uuid>", "at": "<time>"}`
+		have := `{"id": "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a", "at": "2020-01-02T03:04:05Z"}`
+		opt := WithScrubbers(dump.ScrubUUID, dump.ScrubRFC3339)
+
+		// --- When ---
+		err := JSON(want, have, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal with scrubbers", func(t *testing.T) {
+		// --- Given ---
+		want := `{"id": "<uuid>", "n": 1}`
+		have := `{"id": "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a", "n": 2}`
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := JSON(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON strings to be equal:\n" +
+			"  want:\n" +
+			"        {\n" +
+			"          \"id\": \"<uuid>\",\n" +
+			"          \"n\": 1\n" +
+			"        }\n" +
+			"  have:\n" +
+			"        {\n" +
+			"          \"id\": \"<uuid>\",\n" +
+			"          \"n\": 2\n" +
+			"        }\n" +
+			"\n" +
+			"expected JSON values to be equal:\n" +
+			"  pointer: /n\n" +
+			"     want: 1\n" +
+			"     have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_JSONPath(t *testing.T) {
+	doc := `{
+		"id": "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"items": [{"id": 1, "tags": ["a", "b"]}, {"id": 2, "tags": []}],
+		"created": "2025-01-02T03:04:05Z",
+		"my key": {"x": null}
+	}`
+
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath(doc, "$.items[0].id", 1)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - root", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath(`[1, 2]`, "$", []int{1, 2})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - bracket key", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath(doc, `$["my key"].x`, nil)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - object", func(t *testing.T) {
+		// --- Given ---
+		want := map[string]any{"id": 2, "tags": []string{}}
+
+		// --- When ---
+		err := JSONPath(doc, "$.items[1]", want)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - placeholders", func(t *testing.T) {
+		// --- Given ---
+		want := map[string]any{"id": JSONAny, "tags": JSONAny}
+
+		// --- When ---
+		err := JSONPath(doc, "$.items[0]", want)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Nil(t, JSONPath(doc, "$.id", JSONUUID))
+		affirm.Nil(t, JSONPath(doc, "$.created", JSONRFC3339))
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath(doc, "$.items[0].tags[1]", "c")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON values to be equal:\n" +
+			"  trail: $.items[0].tags[1]\n" +
+			"   want: \"c\"\n" +
+			"   have: \"b\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - object differences", func(t *testing.T) {
+		// --- Given ---
+		want := map[string]any{"id": 1, "name": "A"}
+
+		// --- When ---
+		err := JSONPath(doc, "$.items[0]", want)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON object to have key:\n" +
+			"  trail: $.items[0].name\n" +
+			"   want: \"A\"\n" +
+			"\n" +
+			"unexpected JSON object key:\n" +
+			"  trail: $.items[0].tags\n" +
+			"   have: [\"a\",\"b\"]"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - placeholder", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath(doc, "$.created", JSONUUID)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON value to match placeholder:\n" +
+			"  trail: $.created\n" +
+			"   want: <<UUID>>\n" +
+			"   have: \"2025-01-02T03:04:05Z\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - path does not exist", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath(doc, "$.items[5].id", 1)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON path to exist:\n" +
+			"     path: $.items[5].id\n" +
+			"  missing: $.items[5]"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - invalid path", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath(doc, "items", 1)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "invalid JSON path:\n" +
+			"   path: items\n" +
+			"  error: path must start with $"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - invalid doc", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath(`{!!!}`, "$", 1)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect unmarshalling error:\n" +
+			"  argument: doc\n" +
+			"     error: invalid character '!' looking for beginning of " +
+			"object key string"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - want not marshalable", func(t *testing.T) {
+		// --- When ---
+		err := JSONPath(doc, "$", make(chan int))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect marshaling error:\n" +
+			"  argument: want\n" +
+			"     error: json: unsupported type: chan int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := JSONPath(doc, "$.items[1].id", 1, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON values to be equal:\n" +
+			"  trail: type.field.items[1].id\n" +
+			"   want: 1\n" +
+			"   have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		doc := `{"items": [{"id": "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a"}]}`
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := JSONPath(doc, "$.items[0].id", "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_JSONSubset(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		want := `{"user": {"name": "Bob"}, "tags": [{"a": 1}]}`
+		have := `{"id": 1, "user": {"name": "Bob", "age": 42}, ` +
+			`"tags": [{"a": 1, "b": 2}]}`
+
+		// --- When ---
+		err := JSONSubset(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - placeholders", func(t *testing.T) {
+		// --- Given ---
+		want := `{"id": "<<UUID>>", "at": "<<RFC3339>>", "data": "<<ANY>>"}`
+		have := `{"id": "6ba7b810-9dad-11d1-80b4-00c04fd430c8", ` +
+			`"at": "2025-01-02T03:04:05.123+02:00", "data": [1, {}]}`
+
+		// --- When ---
+		err := JSONSubset(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - differences", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": 1, "b": [1, 2], "c": {"my key": true}, "d": "<<UUID>>"}`
+		have := `{"a": "1", "b": [1], "c": {}, "d": 42}`
+
+		// --- When ---
+		err := JSONSubset(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON values to be equal:\n" +
+			"  trail: $.a\n" +
+			"   want: 1\n" +
+			"   have: \"1\"\n" +
+			"\n" +
+			"expected JSON array length:\n" +
+			"  trail: $.b\n" +
+			"   want: 2\n" +
+			"   have: 1\n" +
+			"\n" +
+			"expected JSON object to have key:\n" +
+			"  trail: $.c[\"my key\"]\n" +
+			"   want: true\n" +
+			"\n" +
+			"expected JSON value to match placeholder:\n" +
+			"  trail: $.d\n" +
+			"   want: <<UUID>>\n" +
+			"   have: 42"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - type mismatch", func(t *testing.T) {
+		// --- When ---
+		err := JSONSubset(`{"a": {"b": 1}}`, `{"a": [1]}`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON values to be equal:\n" +
+			"  trail: $.a\n" +
+			"   want: {\"b\":1}\n" +
+			"   have: [1]"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("invalid want JSON", func(t *testing.T) {
+		// --- When ---
+		err := JSONSubset(`{!!!}`, `{}`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect unmarshalling error:\n" +
+			"  argument: want\n" +
+			"     error: invalid character '!' looking for beginning of " +
+			"object key string"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("invalid have JSON", func(t *testing.T) {
+		// --- When ---
+		err := JSONSubset(`{}`, `{!!!}`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect unmarshalling error:\n" +
+			"  argument: have\n" +
+			"     error: invalid character '!' looking for beginning of " +
+			"object key string"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := JSONSubset(`{"a": 1}`, `{"a": 2}`, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected JSON values to be equal:\n" +
+			"  trail: type.field.a\n" +
+			"   want: 1\n" +
+			"   have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		want := `{"id": "<uuid>"}`
+		have := `{"id": "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a", "name": "Bob"}`
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := JSONSubset(want, have, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_parseJSONPath_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		path string
+		want []jsonSelector
+	}{
+		{"root", "$", nil},
+		{"key", "$.a", []jsonSelector{{key: "a"}}},
+		{"index", "$[1]", []jsonSelector{{idx: 1, isIdx: true}}},
+		{
+			"bracket key",
+			`$["a.b]"].c`,
+			[]jsonSelector{{key: "a.b]"}, {key: "c"}},
+		},
+		{
+			"mixed",
+			"$.a[0].b[12]",
+			[]jsonSelector{
+				{key: "a"},
+				{idx: 0, isIdx: true},
+				{key: "b"},
+				{idx: 12, isIdx: true},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := parseJSONPath(tc.path)
+
+			// --- Then ---
+			affirm.Nil(t, err)
+			affirm.DeepEqual(t, tc.want, have)
+		})
+	}
+}
+
+func Test_parseJSONPath_errors_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		path string
+		want string
+	}{
+		{"no root", "a", "path must start with $"},
+		{"empty key", "$..a", "empty key"},
+		{"not closed", "$[1", "missing closing bracket"},
+		{"negative index", "$[-1]", "invalid index -1"},
+		{"invalid index", "$[a]", "invalid index a"},
+		{"invalid key", `$["a\z"]`, `invalid key "a\z"`},
+		{"unexpected char", "$a", `unexpected character 'a'`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := parseJSONPath(tc.path)
+
+			// --- Then ---
+			affirm.Nil(t, have)
+			affirm.NotNil(t, err)
+			affirm.Equal(t, tc.want, err.Error())
+		})
+	}
+}
+
+func Test_jsonNumberEqual_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		want string
+		have string
+		exp  bool
+	}{
+		{"same", "1", "1", true},
+		{"trailing zeros", "1.50", "1.5", true},
+		{"exponent", "1e2", "100", true},
+		{"negative", "-0.5", "-5e-1", true},
+		{"large integers", "9007199254740993", "9007199254740992", false},
+		{"different", "1", "2", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := jsonNumberEqual(json.Number(tc.want), json.Number(tc.have))
+
+			// --- Then ---
+			affirm.Equal(t, tc.exp, have)
+		})
+	}
 }
@@ -4,7 +4,11 @@
 package check
 
 import (
+	"errors"
+	"fmt"
 	"math"
+	"math/cmplx"
+	"reflect"
 	"strconv"
 
 	"github.com/ctx42/testing/internal/constraints"
@@ -14,25 +18,243 @@ import (
 // Epsilon checks the difference between two numbers is within a given delta.
 // Returns nil if it does, otherwise it returns an error with a message
 // indicating the expected and actual values.
+//
+// Integers are compared without converting them to float64, so there is no
+// precision loss for large values. Two infinities with the same sign are
+// considered equal. NaN values are never equal unless [WithEqualNaN] option
+// is used.
 func Epsilon[T constraints.Number](want, epsilon, have T, opts ...Option) error {
-	fWant := float64(want)
-	fHave := float64(have)
-	fDelta := float64(epsilon)
+	ops := DefaultOptions(opts...)
+	if equalFloats(want, have, ops) {
+		return nil
+	}
+	diff, ok := absDiff(want, have)
+	if ok && diff <= epsilon {
+		return nil
+	}
+
+	diffFmt := formatNumber(diff)
+	if !ok {
+		diffFmt = "overflow"
+	}
+	return notice.New("expected numbers to be within given epsilon").
+		Trail(ops.Trail).
+		Want("%s", formatNumber(want)).
+		Have("%s", formatNumber(have)).
+		Append("epsilon", "%s", formatNumber(epsilon)).
+		Append("diff", "%s", diffFmt)
+}
+
+// EpsilonRelative checks the relative difference between two numbers is
+// within a given epsilon. The relative difference is the absolute difference
+// divided by the larger of the absolute values of "want" and "have". Returns
+// nil if it is, otherwise it returns an error with a message indicating the
+// expected and actual values.
+//
+// Two infinities with the same sign are considered equal. NaN values are
+// never equal unless [WithEqualNaN] option is used.
+func EpsilonRelative[T constraints.Float](
+	want, epsilon, have T,
+	opts ...Option,
+) error {
+
+	ops := DefaultOptions(opts...)
+	if equalFloats(want, have, ops) {
+		return nil
+	}
+	fWant, fHave := float64(want), float64(have)
 	diff := math.Abs(fWant - fHave)
-	if diff <= fDelta {
+	rel := diff / math.Max(math.Abs(fWant), math.Abs(fHave))
+	if rel <= float64(epsilon) {
 		return nil
 	}
 
+	return notice.New("expected numbers to be within given relative epsilon").
+		Trail(ops.Trail).
+		Want("%s", formatNumber(want)).
+		Have("%s", formatNumber(have)).
+		Append("epsilon", "%s", formatNumber(epsilon)).
+		Append("diff", "%s", formatNumber(rel))
+}
+
+// WithinULP checks two floating point numbers are at most "ulp" units in the
+// last place (ULP) apart. In other words, there are at most "ulp"
+// representable floating point numbers between "want" and "have". Returns nil
+// if it is, otherwise it returns an error with a message indicating the
+// expected and actual values.
+//
+// NaN values are never equal unless [WithEqualNaN] option is used.
+func WithinULP[T constraints.Float](
+	want T,
+	ulp uint64,
+	have T,
+	opts ...Option,
+) error {
+
 	ops := DefaultOptions(opts...)
+	if equalFloats(want, have, ops) {
+		return nil
+	}
 
-	wantFmt := strconv.FormatFloat(fWant, 'f', -1, 64)
-	haveFmt := strconv.FormatFloat(fHave, 'f', -1, 64)
-	deltaFmt := strconv.FormatFloat(fDelta, 'f', -1, 64)
-	diffFmt := strconv.FormatFloat(diff, 'f', -1, 64)
-	return notice.New("expected numbers to be within given epsilon").
+	var diff uint64
+	fWant, fHave := float64(want), float64(have)
+	nan := math.IsNaN(fWant) || math.IsNaN(fHave)
+	if !nan {
+		if reflect.ValueOf(want).Kind() == reflect.Float32 {
+			diff = ulpDiff32(float32(want), float32(have))
+		} else {
+			diff = ulpDiff64(fWant, fHave)
+		}
+		if diff <= ulp {
+			return nil
+		}
+	}
+
+	diffFmt := strconv.FormatUint(diff, 10)
+	if nan {
+		diffFmt = "NaN"
+	}
+	return notice.New("expected numbers to be within given ULP distance").
 		Trail(ops.Trail).
-		Want("%s", wantFmt).
-		Have("%s", haveFmt).
-		Append("epsilon", "%s", deltaFmt).
+		Want("%s", formatNumber(want)).
+		Have("%s", formatNumber(have)).
+		Append("ulp", "%d", ulp).
 		Append("diff", "%s", diffFmt)
 }
+
+// EpsilonSlice checks corresponding elements of two slices are within a given
+// delta using [Epsilon]. Returns nil if they are, otherwise it returns an
+// error with a message indicating the expected and actual values for every
+// offending index.
+func EpsilonSlice[T constraints.Number](
+	want []T,
+	epsilon T,
+	have []T,
+	opts ...Option,
+) error {
+
+	ops := DefaultOptions(opts...)
+	if len(want) != len(have) {
+		return notice.New("expected slices of the same length").
+			Trail(ops.Trail).
+			Want("%d", len(want)).
+			Have("%d", len(have))
+	}
+
+	var ers []error
+	for i := range want {
+		iOps := ops
+		iOps.Trail = ops.arrTrail("slice", i)
+		err := Epsilon(want[i], epsilon, have[i], WithOptions(iOps))
+		if err != nil {
+			ers = append(ers, err)
+		}
+	}
+	return wrap(errors.Join(ers...))
+}
+
+// EpsilonComplex checks the distance between two complex numbers on the
+// complex plane is within a given delta. Returns nil if it is, otherwise it
+// returns an error with a message indicating the expected and actual values.
+//
+// NaN values are never equal unless [WithEqualNaN] option is used.
+func EpsilonComplex[T constraints.Complex](
+	want T,
+	epsilon float64,
+	have T,
+	opts ...Option,
+) error {
+
+	ops := DefaultOptions(opts...)
+	cWant, cHave := complex128(want), complex128(have)
+	if cWant == cHave {
+		return nil
+	}
+	if ops.EqualNaN && cmplx.IsNaN(cWant) && cmplx.IsNaN(cHave) {
+		return nil
+	}
+	diff := cmplx.Abs(cWant - cHave)
+	if diff <= epsilon {
+		return nil
+	}
+
+	return notice.New("expected complex numbers to be within given epsilon").
+		Trail(ops.Trail).
+		Want("%s", formatNumber(cWant)).
+		Have("%s", formatNumber(cHave)).
+		Append("epsilon", "%s", formatNumber(epsilon)).
+		Append("diff", "%s", formatNumber(diff))
+}
+
+// equalFloats returns true when numbers are equal. For floating point numbers
+// NaN values are considered equal when [Options.EqualNaN] is set.
+func equalFloats[T constraints.Number](want, have T, ops Options) bool {
+	if want == have {
+		return true
+	}
+	return ops.EqualNaN && math.IsNaN(float64(want)) && math.IsNaN(float64(have))
+}
+
+// absDiff returns the absolute difference between two numbers. Returns false
+// if the difference cannot be represented by the type.
+func absDiff[T constraints.Number](a, b T) (T, bool) {
+	var diff T
+	if a > b {
+		diff = a - b
+	} else {
+		diff = b - a
+	}
+	// For signed integers overflow results in negative difference.
+	return diff, !(diff < 0)
+}
+
+// ulpDiff64 returns the number of representable float64 values between two
+// numbers.
+func ulpDiff64(a, b float64) uint64 {
+	ia, ib := int64(math.Float64bits(a)), int64(math.Float64bits(b))
+	if ia < 0 {
+		ia = math.MinInt64 - ia
+	}
+	if ib < 0 {
+		ib = math.MinInt64 - ib
+	}
+	if ia > ib {
+		return uint64(ia) - uint64(ib)
+	}
+	return uint64(ib) - uint64(ia)
+}
+
+// ulpDiff32 returns the number of representable float32 values between two
+// numbers.
+func ulpDiff32(a, b float32) uint64 {
+	ia, ib := int32(math.Float32bits(a)), int32(math.Float32bits(b))
+	if ia < 0 {
+		ia = math.MinInt32 - ia
+	}
+	if ib < 0 {
+		ib = math.MinInt32 - ib
+	}
+	if ia > ib {
+		return uint64(int64(ia) - int64(ib))
+	}
+	return uint64(int64(ib) - int64(ia))
+}
+
+// formatNumber returns string representation of a number. Floating point
+// numbers are formatted with the smallest number of digits necessary to
+// represent the value.
+func formatNumber(v any) string {
+	val := reflect.ValueOf(v)
+	switch val.Kind() {
+	case reflect.Float32:
+		return strconv.FormatFloat(val.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(val.Float(), 'f', -1, 64)
+	case reflect.Complex64:
+		return strconv.FormatComplex(val.Complex(), 'f', -1, 64)
+	case reflect.Complex128:
+		return strconv.FormatComplex(val.Complex(), 'f', -1, 128)
+	default:
+		return fmt.Sprint(v)
+	}
+}
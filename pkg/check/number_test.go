@@ -4,6 +4,7 @@
 package check
 
 import (
+	"math"
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
@@ -62,6 +63,72 @@ func Test_Epsilon(t *testing.T) {
 		affirm.Equal(t, wMsg, err.Error())
 	})
 
+	t.Run("success - large int64 without precision loss", func(t *testing.T) {
+		// --- When ---
+		err := Epsilon(int64(math.MaxInt64), 1, int64(math.MaxInt64-1))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - large int64 without precision loss", func(t *testing.T) {
+		// --- When ---
+		err := Epsilon(int64(math.MaxInt64), 0, int64(math.MaxInt64-1))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected numbers to be within given epsilon:\n" +
+			"     want: 9223372036854775807\n" +
+			"     have: 9223372036854775806\n" +
+			"  epsilon: 0\n" +
+			"     diff: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - int difference overflow", func(t *testing.T) {
+		// --- When ---
+		err := Epsilon(int8(127), 10, int8(-128))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected numbers to be within given epsilon:\n" +
+			"     want: 127\n" +
+			"     have: -128\n" +
+			"  epsilon: 10\n" +
+			"     diff: overflow"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("success - same sign infinities", func(t *testing.T) {
+		// --- When ---
+		err := Epsilon(math.Inf(1), 0.1, math.Inf(1))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - NaN", func(t *testing.T) {
+		// --- When ---
+		err := Epsilon(math.NaN(), 0.1, math.NaN())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected numbers to be within given epsilon:\n" +
+			"     want: NaN\n" +
+			"     have: NaN\n" +
+			"  epsilon: 0.1\n" +
+			"     diff: NaN"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("success - NaN with WithEqualNaN option", func(t *testing.T) {
+		// --- When ---
+		err := Epsilon(math.NaN(), 0.1, math.NaN(), WithEqualNaN)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
 	t.Run("log message with trail", func(t *testing.T) {
 		// --- Given ---
 		opt := WithTrail("type.field")
@@ -80,3 +147,327 @@ func Test_Epsilon(t *testing.T) {
 		affirm.Equal(t, wMsg, err.Error())
 	})
 }
+
+func Test_EpsilonRelative(t *testing.T) {
+	t.Run("success - large numbers", func(t *testing.T) {
+		// --- When ---
+		err := EpsilonRelative(1e20, 0.01, 1.005e20)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - small numbers", func(t *testing.T) {
+		// --- When ---
+		err := EpsilonRelative(1e-20, 0.01, 1.005e-20)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - float32", func(t *testing.T) {
+		// --- When ---
+		err := EpsilonRelative(float32(100), 0.1, float32(95))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - same sign infinities", func(t *testing.T) {
+		// --- When ---
+		err := EpsilonRelative(math.Inf(-1), 0.1, math.Inf(-1))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - NaN with WithEqualNaN option", func(t *testing.T) {
+		// --- When ---
+		err := EpsilonRelative(math.NaN(), 0.1, math.NaN(), WithEqualNaN)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := EpsilonRelative(100.0, 0.01, 98.0)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected numbers to be within given relative epsilon:\n" +
+			"     want: 100\n" +
+			"     have: 98\n" +
+			"  epsilon: 0.01\n" +
+			"     diff: 0.02"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - opposite sign infinities", func(t *testing.T) {
+		// --- When ---
+		err := EpsilonRelative(math.Inf(1), 0.1, math.Inf(-1))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected numbers to be within given relative epsilon:\n" +
+			"     want: +Inf\n" +
+			"     have: -Inf\n" +
+			"  epsilon: 0.1\n" +
+			"     diff: NaN"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := EpsilonRelative(100.0, 0.01, 98.0, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected numbers to be within given relative epsilon:\n" +
+			"    trail: type.field\n" +
+			"     want: 100\n" +
+			"     have: 98\n" +
+			"  epsilon: 0.01\n" +
+			"     diff: 0.02"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_WithinULP(t *testing.T) {
+	t.Run("success - equal", func(t *testing.T) {
+		// --- When ---
+		err := WithinULP(1.0, 0, 1.0)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - float64", func(t *testing.T) {
+		// --- When ---
+		err := WithinULP(0.3, 1, 0.1+0.2)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - float32", func(t *testing.T) {
+		// --- Given ---
+		have := math.Nextafter32(math.Nextafter32(1, 2), 2)
+
+		// --- When ---
+		err := WithinULP(float32(1), 2, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - across zero", func(t *testing.T) {
+		// --- Given ---
+		want := math.Nextafter(0, -1)
+		have := math.Nextafter(0, 1)
+
+		// --- When ---
+		err := WithinULP(want, 2, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - NaN with WithEqualNaN option", func(t *testing.T) {
+		// --- When ---
+		err := WithinULP(math.NaN(), 1, math.NaN(), WithEqualNaN)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		have := math.Nextafter(math.Nextafter(1, 2), 2)
+
+		// --- When ---
+		err := WithinULP(1.0, 1, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected numbers to be within given ULP distance:\n" +
+			"  want: 1\n" +
+			"  have: 1.0000000000000004\n" +
+			"   ulp: 1\n" +
+			"  diff: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - NaN", func(t *testing.T) {
+		// --- When ---
+		err := WithinULP(1.0, 1, math.NaN())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected numbers to be within given ULP distance:\n" +
+			"  want: 1\n" +
+			"  have: NaN\n" +
+			"   ulp: 1\n" +
+			"  diff: NaN"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := WithinULP(float32(1), 0, float32(2), opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected numbers to be within given ULP distance:\n" +
+			"  trail: type.field\n" +
+			"   want: 1\n" +
+			"   have: 2\n" +
+			"    ulp: 0\n" +
+			"   diff: 8388608"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_EpsilonSlice(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		want := []float64{1.0, 2.0, 3.0}
+		have := []float64{1.01, 1.99, 3.0}
+
+		// --- When ---
+		err := EpsilonSlice(want, 0.1, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - empty", func(t *testing.T) {
+		// --- When ---
+		err := EpsilonSlice([]int{}, 1, nil)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - different length", func(t *testing.T) {
+		// --- When ---
+		err := EpsilonSlice([]int{1, 2}, 1, []int{1})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected slices of the same length:\n" +
+			"  want: 2\n" +
+			"  have: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - offending indexes", func(t *testing.T) {
+		// --- Given ---
+		want := []float64{1.0, 2.0, 3.0}
+		have := []float64{1.5, 2.0, 3.5}
+
+		// --- When ---
+		err := EpsilonSlice(want, 0.1, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected numbers to be within given epsilon:\n" +
+			"    trail: <slice>[0]\n" +
+			"     want: 1\n" +
+			"     have: 1.5\n" +
+			"  epsilon: 0.1\n" +
+			"     diff: 0.5\n" +
+			" ---\n" +
+			"    trail: <slice>[2]\n" +
+			"     want: 3\n" +
+			"     have: 3.5\n" +
+			"  epsilon: 0.1\n" +
+			"     diff: 0.5"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := EpsilonSlice([]int{1, 2}, 1, []int{1, 4}, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected numbers to be within given epsilon:\n" +
+			"    trail: type.field[1]\n" +
+			"     want: 2\n" +
+			"     have: 4\n" +
+			"  epsilon: 1\n" +
+			"     diff: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_EpsilonComplex(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := EpsilonComplex(1+1i, 0.1, 1.05+1.05i)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - complex64", func(t *testing.T) {
+		// --- When ---
+		err := EpsilonComplex(complex64(1+1i), 0.1, complex64(1+1i))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - NaN with WithEqualNaN option", func(t *testing.T) {
+		// --- Given ---
+		val := complex(math.NaN(), 0)
+
+		// --- When ---
+		err := EpsilonComplex(val, 0.1, val, WithEqualNaN)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := EpsilonComplex(0+0i, 4, 3+4i)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected complex numbers to be within given epsilon:\n" +
+			"     want: (0+0i)\n" +
+			"     have: (3+4i)\n" +
+			"  epsilon: 4\n" +
+			"     diff: 5"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := EpsilonComplex(0+0i, 4, 3+4i, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected complex numbers to be within given epsilon:\n" +
+			"    trail: type.field\n" +
+			"     want: (0+0i)\n" +
+			"     have: (3+4i)\n" +
+			"  epsilon: 4\n" +
+			"     diff: 5"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
@@ -4,7 +4,10 @@
 package check
 
 import (
+	"image"
+	"io/fs"
 	"reflect"
+	"regexp"
 	"strconv"
 	"time"
 
@@ -94,6 +97,19 @@ func WithDumper(optsD ...dump.Option) Option {
 	}
 }
 
+// WithScrubber is [Check] option adding [dump.Scrubber] replacing parts of
+// dumped values, notice rows and checked texts matching the regular
+// expression with the replacement.
+func WithScrubber(re *regexp.Regexp, replacement string) Option {
+	return WithDumper(dump.WithScrubber(re, replacement))
+}
+
+// WithScrubbers is [Check] option adding scrubbers. Use it with built-in
+// scrubbers like [dump.ScrubUUID] or [dump.ScrubRFC3339].
+func WithScrubbers(scrs ...dump.Scrubber) Option {
+	return WithDumper(dump.WithScrubbers(scrs...))
+}
+
 // WithTypeChecker is [Check] option setting custom checker for a type.
 func WithTypeChecker(typ any, chk Check) Option {
 	return func(ops Options) Options {
@@ -124,6 +140,118 @@ func WithSkipTrail(skip ...string) Option {
 	}
 }
 
+// WithEqualNaN is [Check] option making checks comparing floating point
+// numbers treat two NaN values as equal.
+func WithEqualNaN(ops Options) Options {
+	ops.EqualNaN = true
+	return ops
+}
+
+// WithUnorderedArrays is [Check] option making checks comparing JSON
+// documents treat arrays as unordered collections.
+func WithUnorderedArrays(ops Options) Options {
+	ops.UnorderedArrays = true
+	return ops
+}
+
+// WithIgnoreComments is [Check] option making checks comparing XML documents
+// ignore comments.
+func WithIgnoreComments(ops Options) Options {
+	ops.IgnoreComments = true
+	return ops
+}
+
+// WithUnorderedColumns is [Check] option making checks comparing tabular
+// data ignore the order of columns.
+func WithUnorderedColumns(ops Options) Options {
+	ops.UnorderedColumns = true
+	return ops
+}
+
+// WithUnorderedRows is [Check] option making checks comparing tabular data
+// ignore the order of rows.
+func WithUnorderedRows(ops Options) Options {
+	ops.UnorderedRows = true
+	return ops
+}
+
+// WithKeyColumns is [Check] option setting columns identifying rows when
+// comparing tabular data. Rows with the same key column values are compared
+// regardless of their order.
+func WithKeyColumns(cols ...string) Option {
+	return func(ops Options) Options {
+		ops.KeyColumns = append(ops.KeyColumns, cols...)
+		return ops
+	}
+}
+
+// WithIgnoreGlobs is [Check] option setting [path.Match] patterns of paths
+// to ignore when comparing directories.
+func WithIgnoreGlobs(patterns ...string) Option {
+	return func(ops Options) Options {
+		ops.IgnoreGlobs = append(ops.IgnoreGlobs, patterns...)
+		return ops
+	}
+}
+
+// WithCompareMode is [Check] option making checks comparing directories also
+// compare file modes and permissions.
+func WithCompareMode(ops Options) Options {
+	ops.CompareMode = true
+	return ops
+}
+
+// WithUpdateDir is [Check] option making checks comparing against golden
+// directories update them with the actual values instead of comparing.
+func WithUpdateDir(ops Options) Options {
+	ops.UpdateDir = true
+	return ops
+}
+
+// WithUpdateInline is [Check] option making assertions comparing against
+// inline snapshots rewrite them in the test source file with the actual
+// values instead of comparing.
+func WithUpdateInline(ops Options) Options {
+	ops.UpdateInline = true
+	return ops
+}
+
+// WithImageTolerance is [Check] option setting maximum difference of color
+// channel values for pixels to be considered equal when comparing images.
+func WithImageTolerance(tolerance uint8) Option {
+	return func(ops Options) Options {
+		ops.ImageTolerance = tolerance
+		return ops
+	}
+}
+
+// WithImageMaxDiff is [Check] option setting maximum number of differing
+// pixels for images to be considered equal.
+func WithImageMaxDiff(n int) Option {
+	return func(ops Options) Options {
+		ops.ImageMaxDiff = n
+		return ops
+	}
+}
+
+// WithImageIgnore is [Check] option setting regions to ignore when comparing
+// images. Regions are relative to the top left corner of the images.
+func WithImageIgnore(regions ...image.Rectangle) Option {
+	return func(ops Options) Options {
+		ops.ImageIgnore = append(ops.ImageIgnore, regions...)
+		return ops
+	}
+}
+
+// WithFS is [Check] option setting filesystem used by filesystem checks
+// instead of the operating system filesystem.
+func WithFS(fsys fs.FS) Option {
+	return func(ops Options) Options {
+		ops.FS = fsys
+		return ops
+	}
+}
+
 // WithOptions is [Check] option which passes all options.
 func WithOptions(src Options) Option {
 	return func(ops Options) Options {
@@ -135,6 +263,20 @@ func WithOptions(src Options) Option {
 		ops.TypeCheckers = src.TypeCheckers
 		ops.TrailCheckers = src.TrailCheckers
 		ops.SkipTrails = src.SkipTrails
+		ops.EqualNaN = src.EqualNaN
+		ops.UnorderedArrays = src.UnorderedArrays
+		ops.IgnoreComments = src.IgnoreComments
+		ops.UnorderedColumns = src.UnorderedColumns
+		ops.UnorderedRows = src.UnorderedRows
+		ops.KeyColumns = src.KeyColumns
+		ops.IgnoreGlobs = src.IgnoreGlobs
+		ops.CompareMode = src.CompareMode
+		ops.UpdateDir = src.UpdateDir
+		ops.UpdateInline = src.UpdateInline
+		ops.ImageTolerance = src.ImageTolerance
+		ops.ImageMaxDiff = src.ImageMaxDiff
+		ops.ImageIgnore = src.ImageIgnore
+		ops.FS = src.FS
 		ops.now = src.now
 		return ops
 	}
@@ -167,6 +309,50 @@ type Options struct {
 	// List of trails to skip.
 	SkipTrails []string
 
+	// Treat NaN values as equal when comparing floating point numbers.
+	EqualNaN bool
+
+	// Treat arrays as unordered when comparing JSON documents.
+	UnorderedArrays bool
+
+	// Ignore comments when comparing XML documents.
+	IgnoreComments bool
+
+	// Ignore the order of columns when comparing tabular data.
+	UnorderedColumns bool
+
+	// Ignore the order of rows when comparing tabular data.
+	UnorderedRows bool
+
+	// Columns identifying rows when comparing tabular data.
+	KeyColumns []string
+
+	// Patterns of paths to ignore when comparing directories.
+	IgnoreGlobs []string
+
+	// Compare file modes and permissions when comparing directories.
+	CompareMode bool
+
+	// Update golden directories with actual values.
+	UpdateDir bool
+
+	// Rewrite inline snapshots in test source files with actual values.
+	UpdateInline bool
+
+	// Maximum difference of color channel values for pixels to be
+	// considered equal when comparing images.
+	ImageTolerance uint8
+
+	// Maximum number of differing pixels when comparing images.
+	ImageMaxDiff int
+
+	// Regions to ignore when comparing images.
+	ImageIgnore []image.Rectangle
+
+	// Filesystem used by filesystem checks. When nil, the operating system
+	// filesystem is used.
+	FS fs.FS
+
 	// Function used to get current time. Used preliminary to inject clock in
 	// tests of checks and assertions using [time.Now].
 	now func() time.Time
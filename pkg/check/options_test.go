@@ -4,8 +4,11 @@
 package check
 
 import (
+	"image"
 	"reflect"
+	"regexp"
 	"testing"
+	"testing/fstest"
 	"time"
 
 	"github.com/ctx42/testing/internal/affirm"
@@ -71,6 +74,35 @@ func Test_WithDumper(t *testing.T) {
 	affirm.Equal(t, 100, have.Dumper.MaxDepth)
 }
 
+func Test_WithScrubber(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+	re := regexp.MustCompile(`id-\d+`)
+
+	// --- When ---
+	have := WithScrubber(re, "<id>")(ops)
+
+	// --- Then ---
+	affirm.Equal(t, 0, len(ops.Dumper.Scrubbers))
+	affirm.Equal(t, 1, len(have.Dumper.Scrubbers))
+	affirm.True(t, re == have.Dumper.Scrubbers[0].Pattern)
+	affirm.Equal(t, "<id>", have.Dumper.Scrubbers[0].Replacement)
+}
+
+func Test_WithScrubbers(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithScrubbers(dump.ScrubUUID, dump.ScrubTempDir)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, 0, len(ops.Dumper.Scrubbers))
+	affirm.Equal(t, 2, len(have.Dumper.Scrubbers))
+	affirm.Equal(t, "<uuid>", have.Dumper.Scrubbers[0].Replacement)
+	affirm.Equal(t, "<tempdir>", have.Dumper.Scrubbers[1].Replacement)
+}
+
 func Test_WithTypeChecker(t *testing.T) {
 	// --- Given ---
 	ops := Options{}
@@ -109,6 +141,163 @@ func Test_WithSkipTrail(t *testing.T) {
 	affirm.DeepEqual(t, []string{"type.field1", "type.field2"}, have.SkipTrails)
 }
 
+func Test_WithEqualNaN(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithEqualNaN(ops)
+
+	// --- Then ---
+	affirm.True(t, have.EqualNaN)
+}
+
+func Test_WithUnorderedArrays(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithUnorderedArrays(ops)
+
+	// --- Then ---
+	affirm.True(t, have.UnorderedArrays)
+}
+
+func Test_WithIgnoreComments(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithIgnoreComments(ops)
+
+	// --- Then ---
+	affirm.True(t, have.IgnoreComments)
+}
+
+func Test_WithUnorderedColumns(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithUnorderedColumns(ops)
+
+	// --- Then ---
+	affirm.True(t, have.UnorderedColumns)
+}
+
+func Test_WithUnorderedRows(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithUnorderedRows(ops)
+
+	// --- Then ---
+	affirm.True(t, have.UnorderedRows)
+}
+
+func Test_WithKeyColumns(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithKeyColumns("a", "b")(ops)
+
+	// --- Then ---
+	affirm.DeepEqual(t, []string{"a", "b"}, have.KeyColumns)
+}
+
+func Test_WithIgnoreGlobs(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithIgnoreGlobs("*.log", "tmp")(ops)
+
+	// --- Then ---
+	affirm.DeepEqual(t, []string{"*.log", "tmp"}, have.IgnoreGlobs)
+}
+
+func Test_WithCompareMode(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithCompareMode(ops)
+
+	// --- Then ---
+	affirm.True(t, have.CompareMode)
+}
+
+func Test_WithUpdateDir(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithUpdateDir(ops)
+
+	// --- Then ---
+	affirm.True(t, have.UpdateDir)
+}
+
+func Test_WithUpdateInline(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithUpdateInline(ops)
+
+	// --- Then ---
+	affirm.True(t, have.UpdateInline)
+}
+
+func Test_WithImageTolerance(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithImageTolerance(5)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, uint8(5), have.ImageTolerance)
+}
+
+func Test_WithImageMaxDiff(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithImageMaxDiff(10)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, 10, have.ImageMaxDiff)
+}
+
+func Test_WithImageIgnore(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+	r0 := image.Rect(0, 0, 1, 1)
+	r1 := image.Rect(2, 2, 4, 4)
+
+	// --- When ---
+	have := WithImageIgnore(r0, r1)(ops)
+
+	// --- Then ---
+	affirm.DeepEqual(t, []image.Rectangle{r0, r1}, have.ImageIgnore)
+}
+
+func Test_WithFS(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+	fsys := fstest.MapFS{}
+
+	// --- When ---
+	have := WithFS(fsys)(ops)
+
+	// --- Then ---
+	affirm.True(t, core.Same(fsys, have.FS))
+}
+
 func Test_WithOptions(t *testing.T) {
 	// --- Given ---
 	trailLog := make([]string, 0)
@@ -126,13 +315,28 @@ func Test_WithOptions(t *testing.T) {
 			},
 			MaxDepth: 6,
 		},
-		TimeFormat:    time.RFC3339,
-		Recent:        123,
-		Trail:         "trail",
-		TrailLog:      &trailLog,
-		TypeCheckers:  make(map[reflect.Type]Check),
-		TrailCheckers: make(map[string]Check),
-		now:           time.Now,
+		TimeFormat:       time.RFC3339,
+		Recent:           123,
+		Trail:            "trail",
+		TrailLog:         &trailLog,
+		TypeCheckers:     make(map[reflect.Type]Check),
+		TrailCheckers:    make(map[string]Check),
+		SkipTrails:       []string{"skip"},
+		EqualNaN:         true,
+		UnorderedArrays:  true,
+		IgnoreComments:   true,
+		UnorderedColumns: true,
+		UnorderedRows:    true,
+		KeyColumns:       []string{"id"},
+		IgnoreGlobs:      []string{"*.log"},
+		CompareMode:      true,
+		UpdateDir:        true,
+		UpdateInline:     true,
+		ImageTolerance:   1,
+		ImageMaxDiff:     2,
+		ImageIgnore:      []image.Rectangle{image.Rect(0, 0, 1, 1)},
+		FS:               fstest.MapFS{},
+		now:              time.Now,
 	}
 
 	// --- When ---
@@ -166,8 +370,22 @@ func Test_DefaultOptions(t *testing.T) {
 		affirm.True(t, have.TypeCheckers == nil)
 		affirm.True(t, have.TrailCheckers == nil)
 		affirm.True(t, have.SkipTrails == nil)
+		affirm.False(t, have.EqualNaN)
+		affirm.False(t, have.UnorderedArrays)
+		affirm.False(t, have.IgnoreComments)
+		affirm.False(t, have.UnorderedColumns)
+		affirm.False(t, have.UnorderedRows)
+		affirm.True(t, have.KeyColumns == nil)
+		affirm.True(t, have.IgnoreGlobs == nil)
+		affirm.False(t, have.CompareMode)
+		affirm.False(t, have.UpdateDir)
+		affirm.False(t, have.UpdateInline)
+		affirm.Equal(t, uint8(0), have.ImageTolerance)
+		affirm.Equal(t, 0, have.ImageMaxDiff)
+		affirm.True(t, have.ImageIgnore == nil)
+		affirm.True(t, have.FS == nil)
 		affirm.True(t, core.Same(time.Now, have.now))
-		affirm.Equal(t, 9, reflect.ValueOf(have).NumField())
+		affirm.Equal(t, 23, reflect.ValueOf(have).NumField())
 	})
 
 	t.Run("with options", func(t *testing.T) {
@@ -185,8 +403,22 @@ func Test_DefaultOptions(t *testing.T) {
 		affirm.True(t, have.TypeCheckers == nil)
 		affirm.True(t, have.TrailCheckers == nil)
 		affirm.True(t, have.SkipTrails == nil)
+		affirm.False(t, have.EqualNaN)
+		affirm.False(t, have.UnorderedArrays)
+		affirm.False(t, have.IgnoreComments)
+		affirm.False(t, have.UnorderedColumns)
+		affirm.False(t, have.UnorderedRows)
+		affirm.True(t, have.KeyColumns == nil)
+		affirm.True(t, have.IgnoreGlobs == nil)
+		affirm.False(t, have.CompareMode)
+		affirm.False(t, have.UpdateDir)
+		affirm.False(t, have.UpdateInline)
+		affirm.Equal(t, uint8(0), have.ImageTolerance)
+		affirm.Equal(t, 0, have.ImageMaxDiff)
+		affirm.True(t, have.ImageIgnore == nil)
+		affirm.True(t, have.FS == nil)
 		affirm.True(t, core.Same(time.Now, have.now))
-		affirm.Equal(t, 9, reflect.ValueOf(have).NumField())
+		affirm.Equal(t, 23, reflect.ValueOf(have).NumField())
 	})
 }
 
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"github.com/ctx42/testing/internal/constraints"
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// Greater checks "have" is greater than "want". Returns nil if it is,
+// otherwise it returns an error with a message indicating the expected and
+// actual values.
+func Greater[T constraints.Ordered](want, have T, opts ...Option) error {
+	if have > want {
+		return nil
+	}
+	return orderedError("greater than", want, have, opts...)
+}
+
+// GreaterOrEqual checks "have" is greater or equal to "want". Returns nil if
+// it is, otherwise it returns an error with a message indicating the expected
+// and actual values.
+func GreaterOrEqual[T constraints.Ordered](want, have T, opts ...Option) error {
+	if have >= want {
+		return nil
+	}
+	return orderedError("greater or equal to", want, have, opts...)
+}
+
+// Less checks "have" is less than "want". Returns nil if it is, otherwise it
+// returns an error with a message indicating the expected and actual values.
+func Less[T constraints.Ordered](want, have T, opts ...Option) error {
+	if have < want {
+		return nil
+	}
+	return orderedError("less than", want, have, opts...)
+}
+
+// LessOrEqual checks "have" is less or equal to "want". Returns nil if it is,
+// otherwise it returns an error with a message indicating the expected and
+// actual values.
+func LessOrEqual[T constraints.Ordered](want, have T, opts ...Option) error {
+	if have <= want {
+		return nil
+	}
+	return orderedError("less or equal to", want, have, opts...)
+}
+
+// Between checks "have" is in the closed range from "lo" to "hi" - in other
+// words lo <= have <= hi. Returns nil if it is, otherwise it returns an error
+// with a message indicating the expected and actual values. It also returns
+// an error when "lo" is greater than "hi". Comparisons with NaN always fail.
+func Between[T constraints.Ordered](lo, hi, have T, opts ...Option) error {
+	if lo > hi {
+		ops := DefaultOptions(opts...)
+		return notice.New("expected valid range").
+			Trail(ops.Trail).
+			Append("lo", "%s", ops.Dumper.Any(lo)).
+			Append("hi", "%s", ops.Dumper.Any(hi)).
+			Append("violated", "lo <= hi")
+	}
+	if lo <= have && have <= hi {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	relation := "lo <= have"
+	if lo <= have {
+		relation = "have <= hi"
+	}
+	return notice.New("expected value to be within range").
+		Trail(ops.Trail).
+		Append("lo", "%s", ops.Dumper.Any(lo)).
+		Append("hi", "%s", ops.Dumper.Any(hi)).
+		Have("%s", ops.Dumper.Any(have)).
+		Append("violated", "%s", relation)
+}
+
+// Positive checks "have" is greater than zero. Returns nil if it is,
+// otherwise it returns an error with a message indicating the expected and
+// actual values.
+func Positive[T constraints.Number](have T, opts ...Option) error {
+	if have > 0 {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return notice.New("expected value to be positive").
+		Trail(ops.Trail).
+		Have("%s", ops.Dumper.Any(have)).
+		Append("violated", "have > 0")
+}
+
+// Negative checks "have" is less than zero. Returns nil if it is, otherwise
+// it returns an error with a message indicating the expected and actual
+// values. For unsigned types it always returns an error.
+func Negative[T constraints.Number](have T, opts ...Option) error {
+	if have < 0 {
+		return nil
+	}
+	ops := DefaultOptions(opts...)
+	return notice.New("expected value to be negative").
+		Trail(ops.Trail).
+		Have("%s", ops.Dumper.Any(have)).
+		Append("violated", "have < 0")
+}
+
+// orderedError returns error for values which do not satisfy the relation.
+func orderedError[T constraints.Ordered](
+	relation string,
+	want, have T,
+	opts ...Option,
+) *notice.Notice {
+
+	ops := DefaultOptions(opts...)
+	return notice.New("expected \"have\" to be %s \"want\"", relation).
+		Trail(ops.Trail).
+		Want("%s", ops.Dumper.Any(want)).
+		Have("%s", ops.Dumper.Any(have))
+}
@@ -0,0 +1,527 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_Greater(t *testing.T) {
+	t.Run("success - int", func(t *testing.T) {
+		// --- When ---
+		err := Greater(41, 42)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - float64", func(t *testing.T) {
+		// --- When ---
+		err := Greater(41.9, 42.0)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - string", func(t *testing.T) {
+		// --- When ---
+		err := Greater("abc", "abd")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - equal", func(t *testing.T) {
+		// --- When ---
+		err := Greater(42, 42)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be greater than \"want\":\n" +
+			"  want: 42\n" +
+			"  have: 42"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - string", func(t *testing.T) {
+		// --- When ---
+		err := Greater("abd", "abc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be greater than \"want\":\n" +
+			"  want: \"abd\"\n" +
+			"  have: \"abc\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - NaN", func(t *testing.T) {
+		// --- When ---
+		err := Greater(1.0, math.NaN())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be greater than \"want\":\n" +
+			"  want: 1\n" +
+			"  have: NaN"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Greater(42, 41, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be greater than \"want\":\n" +
+			"  trail: type.field\n" +
+			"   want: 42\n" +
+			"   have: 41"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - NaN want", func(t *testing.T) {
+		// --- When ---
+		err := Greater(math.NaN(), 1.0)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be greater than \"want\":\n" +
+			"  want: NaN\n" +
+			"  have: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_GreaterOrEqual(t *testing.T) {
+	t.Run("success - greater", func(t *testing.T) {
+		// --- When ---
+		err := GreaterOrEqual(41, 42)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - equal", func(t *testing.T) {
+		// --- When ---
+		err := GreaterOrEqual(42, 42)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := GreaterOrEqual(uint(42), uint(41))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be greater or equal to \"want\":\n" +
+			"  want: 42\n" +
+			"  have: 41"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := GreaterOrEqual(42, 41, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be greater or equal to \"want\":\n" +
+			"  trail: type.field\n" +
+			"   want: 42\n" +
+			"   have: 41"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - NaN", func(t *testing.T) {
+		// --- When ---
+		err := GreaterOrEqual(math.NaN(), math.NaN())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be greater or equal to \"want\":\n" +
+			"  want: NaN\n" +
+			"  have: NaN"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Less(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := Less(42, 41)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - equal", func(t *testing.T) {
+		// --- When ---
+		err := Less(42.5, 42.5)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be less than \"want\":\n" +
+			"  want: 42.5\n" +
+			"  have: 42.5"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Less(41, 42, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be less than \"want\":\n" +
+			"  trail: type.field\n" +
+			"   want: 41\n" +
+			"   have: 42"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - NaN have", func(t *testing.T) {
+		// --- When ---
+		err := Less(1.0, math.NaN())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be less than \"want\":\n" +
+			"  want: 1\n" +
+			"  have: NaN"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - NaN want", func(t *testing.T) {
+		// --- When ---
+		err := Less(math.NaN(), 1.0)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be less than \"want\":\n" +
+			"  want: NaN\n" +
+			"  have: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_LessOrEqual(t *testing.T) {
+	t.Run("success - less", func(t *testing.T) {
+		// --- When ---
+		err := LessOrEqual(42, 41)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - equal", func(t *testing.T) {
+		// --- When ---
+		err := LessOrEqual("abc", "abc")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := LessOrEqual(41, 42)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be less or equal to \"want\":\n" +
+			"  want: 41\n" +
+			"  have: 42"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := LessOrEqual(41, 42, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be less or equal to \"want\":\n" +
+			"  trail: type.field\n" +
+			"   want: 41\n" +
+			"   have: 42"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - NaN", func(t *testing.T) {
+		// --- When ---
+		err := LessOrEqual(math.NaN(), math.NaN())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected \"have\" to be less or equal to \"want\":\n" +
+			"  want: NaN\n" +
+			"  have: NaN"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Between(t *testing.T) {
+	t.Run("success - inside", func(t *testing.T) {
+		// --- When ---
+		err := Between(1, 3, 2)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - lower bound", func(t *testing.T) {
+		// --- When ---
+		err := Between(1, 3, 1)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - upper bound", func(t *testing.T) {
+		// --- When ---
+		err := Between(1, 3, 3)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - below", func(t *testing.T) {
+		// --- When ---
+		err := Between(1, 3, 0)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be within range:\n" +
+			"        lo: 1\n" +
+			"        hi: 3\n" +
+			"      have: 0\n" +
+			"  violated: lo <= have"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - above", func(t *testing.T) {
+		// --- When ---
+		err := Between("b", "d", "e")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be within range:\n" +
+			"        lo: \"b\"\n" +
+			"        hi: \"d\"\n" +
+			"      have: \"e\"\n" +
+			"  violated: have <= hi"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Between(1.5, 2.5, 3.0, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be within range:\n" +
+			"     trail: type.field\n" +
+			"        lo: 1.5\n" +
+			"        hi: 2.5\n" +
+			"      have: 3\n" +
+			"  violated: have <= hi"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - NaN lo", func(t *testing.T) {
+		// --- When ---
+		err := Between(math.NaN(), 5.0, 3.0)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be within range:\n" +
+			"        lo: NaN\n" +
+			"        hi: 5\n" +
+			"      have: 3\n" +
+			"  violated: lo <= have"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - NaN hi", func(t *testing.T) {
+		// --- When ---
+		err := Between(1.0, math.NaN(), 3.0)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be within range:\n" +
+			"        lo: 1\n" +
+			"        hi: NaN\n" +
+			"      have: 3\n" +
+			"  violated: have <= hi"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - NaN have", func(t *testing.T) {
+		// --- When ---
+		err := Between(1.0, 5.0, math.NaN())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be within range:\n" +
+			"        lo: 1\n" +
+			"        hi: 5\n" +
+			"      have: NaN\n" +
+			"  violated: lo <= have"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - lo greater than hi", func(t *testing.T) {
+		// --- When ---
+		err := Between(5, 1, 3, WithTrail("type.field"))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid range:\n" +
+			"     trail: type.field\n" +
+			"        lo: 5\n" +
+			"        hi: 1\n" +
+			"  violated: lo <= hi"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Positive(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := Positive(1)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - unsigned", func(t *testing.T) {
+		// --- When ---
+		err := Positive(uint(3))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - unsigned zero", func(t *testing.T) {
+		// --- When ---
+		err := Positive(uint(0))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be positive:\n" +
+			"      have: 0\n" +
+			"  violated: have > 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - zero", func(t *testing.T) {
+		// --- When ---
+		err := Positive(0)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be positive:\n" +
+			"      have: 0\n" +
+			"  violated: have > 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - NaN", func(t *testing.T) {
+		// --- When ---
+		err := Positive(math.NaN())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be positive:\n" +
+			"      have: NaN\n" +
+			"  violated: have > 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Positive(-1.5, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be positive:\n" +
+			"     trail: type.field\n" +
+			"      have: -1.5\n" +
+			"  violated: have > 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Negative(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := Negative(int8(-1))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - unsigned", func(t *testing.T) {
+		// --- When ---
+		err := Negative(uint(3))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be negative:\n" +
+			"      have: 3\n" +
+			"  violated: have < 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - zero", func(t *testing.T) {
+		// --- When ---
+		err := Negative(0.0)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be negative:\n" +
+			"      have: 0\n" +
+			"  violated: have < 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Negative(1, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to be negative:\n" +
+			"     trail: type.field\n" +
+			"      have: 1\n" +
+			"  violated: have < 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
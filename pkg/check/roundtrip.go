@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// RoundTripJSON checks "have" value marshaled to JSON and unmarshalled to a
+// new value of the same type is equal to "have" using [Equal]. Returns nil if
+// it is, otherwise it returns an error with a message indicating the encoded
+// form and the differences.
+func RoundTripJSON(have any, opts ...Option) error {
+	return roundTrip("JSON", have, json.Marshal, json.Unmarshal, false, opts)
+}
+
+// RoundTripText checks "have" value marshaled with [encoding.TextMarshaler]
+// and unmarshalled with [encoding.TextUnmarshaler] to a new value of the same
+// type is equal to "have" using [Equal]. Returns nil if it is, otherwise it
+// returns an error with a message indicating the encoded form and the
+// differences.
+func RoundTripText(have any, opts ...Option) error {
+	if err := Implements[encoding.TextMarshaler](have, opts...); err != nil {
+		return err
+	}
+	enc := func(v any) ([]byte, error) {
+		return v.(encoding.TextMarshaler).MarshalText() // nolint: forcetypeassert
+	}
+	dec := func(data []byte, v any) error {
+		if err := Implements[encoding.TextUnmarshaler](v, opts...); err != nil {
+			return err
+		}
+		return v.(encoding.TextUnmarshaler).UnmarshalText(data) // nolint: forcetypeassert
+	}
+	return roundTrip("text", have, enc, dec, false, opts)
+}
+
+// RoundTripBinary checks "have" value marshaled with
+// [encoding.BinaryMarshaler] and unmarshalled with
+// [encoding.BinaryUnmarshaler] to a new value of the same type is equal to
+// "have" using [Equal]. Returns nil if it is, otherwise it returns an error
+// with a message indicating the encoded form and the differences.
+func RoundTripBinary(have any, opts ...Option) error {
+	if err := Implements[encoding.BinaryMarshaler](have, opts...); err != nil {
+		return err
+	}
+	enc := func(v any) ([]byte, error) {
+		return v.(encoding.BinaryMarshaler).MarshalBinary() // nolint: forcetypeassert
+	}
+	dec := func(data []byte, v any) error {
+		if err := Implements[encoding.BinaryUnmarshaler](v, opts...); err != nil {
+			return err
+		}
+		return v.(encoding.BinaryUnmarshaler).UnmarshalBinary(data) // nolint: forcetypeassert
+	}
+	return roundTrip("binary", have, enc, dec, true, opts)
+}
+
+// RoundTripGob checks "have" value encoded with [gob] and decoded to a new
+// value of the same type is equal to "have" using [Equal]. Returns nil if it
+// is, otherwise it returns an error with a message indicating the encoded
+// form and the differences.
+func RoundTripGob(have any, opts ...Option) error {
+	enc := func(v any) ([]byte, error) {
+		buf := &bytes.Buffer{}
+		err := gob.NewEncoder(buf).Encode(v)
+		return buf.Bytes(), err
+	}
+	dec := func(data []byte, v any) error {
+		return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+	}
+	return roundTrip("gob", have, enc, dec, true, opts)
+}
+
+// RoundTripXML checks "have" value marshaled to XML and unmarshalled to a new
+// value of the same type is equal to "have" using [Equal]. Returns nil if it
+// is, otherwise it returns an error with a message indicating the encoded
+// form and the differences.
+func RoundTripXML(have any, opts ...Option) error {
+	return roundTrip("XML", have, xml.Marshal, xml.Unmarshal, false, opts)
+}
+
+// roundTrip encodes "have" value, decodes it to a new value of the same type
+// and compares both values using [Equal]. The "binary" argument controls if
+// the encoded form is displayed as a hex dump.
+func roundTrip(
+	format string,
+	have any,
+	encode func(v any) ([]byte, error),
+	decode func(data []byte, v any) error,
+	binary bool,
+	opts []Option,
+) error {
+
+	ops := DefaultOptions(opts...)
+	if have == nil {
+		return notice.New("expected non-nil value").Trail(ops.Trail)
+	}
+	data, err := encode(have)
+	if err != nil {
+		return notice.New("did not expect marshaling error").
+			Trail(ops.Trail).
+			Append("format", "%s", format).
+			Append("error", "%s", err)
+	}
+	encoded := string(data)
+	if binary {
+		encoded = strings.TrimSuffix(hex.Dump(data), "\n")
+	}
+
+	target, result := newValue(reflect.TypeOf(have))
+	if err = decode(data, target); err != nil {
+		var msg *notice.Notice
+		if errors.As(err, &msg) {
+			return msg
+		}
+		return notice.New("did not expect unmarshalling error").
+			Trail(ops.Trail).
+			Append("format", "%s", format).
+			Append("error", "%s", err).
+			Append("encoded", "%s", encoded)
+	}
+
+	ers := notice.Unwrap(Equal(have, result(), WithOptions(ops)))
+	if len(ers) == 0 {
+		return nil
+	}
+	msg := notice.New("expected value to survive %s round trip", format).
+		Trail(ops.Trail).
+		Append("encoded", "%s", encoded)
+	return wrap(errors.Join(append([]error{msg}, ers...)...))
+}
+
+// newValue returns a pointer to a new zero value of the given type to decode
+// into and a function returning the decoded value. For pointer types, the
+// pointer to a new zero value of the element type is used.
+func newValue(typ reflect.Type) (any, func() any) {
+	if typ.Kind() == reflect.Ptr {
+		val := reflect.New(typ.Elem())
+		return val.Interface(), val.Interface
+	}
+	val := reflect.New(typ)
+	return val.Interface(), func() any { return val.Elem().Interface() }
+}
@@ -0,0 +1,375 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+)
+
+func Test_RoundTripJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		have := types.TA{
+			Int: 1,
+			Str: "abc",
+			Tim: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			Dur: time.Second,
+		}
+
+		// --- When ---
+		err := RoundTripJSON(have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - pointer", func(t *testing.T) {
+		// --- Given ---
+		have := &types.TIntStr{Int: 1, Str: "abc"}
+
+		// --- When ---
+		err := RoundTripJSON(have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]any{"A": 1}
+
+		// --- When ---
+		err := RoundTripJSON(have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to survive JSON round trip:\n" +
+			"  encoded: {\"A\":1}\n" +
+			"\n" +
+			"expected values to be equal:\n" +
+			"      trail: map[\"A\"]\n" +
+			"       want: 1\n" +
+			"       have: 1\n" +
+			"  want type: int\n" +
+			"  have type: float64"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - nil", func(t *testing.T) {
+		// --- When ---
+		err := RoundTripJSON(nil)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, "expected non-nil value", err.Error())
+	})
+
+	t.Run("error - marshaling", func(t *testing.T) {
+		// --- When ---
+		err := RoundTripJSON(make(chan int))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect marshaling error:\n" +
+			"  format: JSON\n" +
+			"   error: json: unsupported type: chan int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]any{"A": 1}
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := RoundTripJSON(have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to survive JSON round trip:\n" +
+			"    trail: type.field\n" +
+			"  encoded: {\"A\":1}\n" +
+			"\n" +
+			"expected values to be equal:\n" +
+			"      trail: type.field[\"A\"]\n" +
+			"       want: 1\n" +
+			"       have: 1\n" +
+			"  want type: int\n" +
+			"  have type: float64"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_RoundTripText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		have := types.TTxt{Val: "abc"}
+
+		// --- When ---
+		err := RoundTripText(have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - pointer", func(t *testing.T) {
+		// --- Given ---
+		have := &types.TTxt{Val: "abc"}
+
+		// --- When ---
+		err := RoundTripText(have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		have := types.TTxt{Val: "abc", Num: 1}
+
+		// --- When ---
+		err := RoundTripText(have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to survive text round trip:\n" +
+			"  encoded: abc\n" +
+			"\n" +
+			"expected values to be equal:\n" +
+			"  trail: TTxt.Num\n" +
+			"   want: 1\n" +
+			"   have: 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not marshaler", func(t *testing.T) {
+		// --- When ---
+		err := RoundTripText(1)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to implement interface:\n" +
+			"     want: encoding.TextMarshaler\n" +
+			"     have: int\n" +
+			"  missing: MarshalText() ([]uint8, error)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not unmarshaler", func(t *testing.T) {
+		// --- When ---
+		err := RoundTripText(types.TTxtEnc("abc"))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to implement interface:\n" +
+			"     want: encoding.TextUnmarshaler\n" +
+			"     have: *types.TTxtEnc\n" +
+			"  missing: UnmarshalText([]uint8) error"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - unmarshalling", func(t *testing.T) {
+		// --- When ---
+		err := RoundTripText(types.TTxt{})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect unmarshalling error:\n" +
+			"   format: text\n" +
+			"    error: empty text\n" +
+			"  encoded: "
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		have := types.TTxt{Val: "abc", Num: 1}
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := RoundTripText(have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to survive text round trip:\n" +
+			"    trail: type.field\n" +
+			"  encoded: abc\n" +
+			"\n" +
+			"expected values to be equal:\n" +
+			"  trail: type.field.Num\n" +
+			"   want: 1\n" +
+			"   have: 0"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_RoundTripBinary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		have := types.TBin{Val: 258}
+
+		// --- When ---
+		err := RoundTripBinary(have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		have := &types.TBin{Val: 258, Tag: "abc"}
+
+		// --- When ---
+		err := RoundTripBinary(have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to survive binary round trip:\n" +
+			"  encoded: 00000000  01 02" +
+			"                                             |..|\n" +
+			"\n" +
+			"expected values to be equal:\n" +
+			"  trail: TBin.Tag\n" +
+			"   want: \"abc\"\n" +
+			"   have: \"\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not marshaler", func(t *testing.T) {
+		// --- When ---
+		err := RoundTripBinary("abc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to implement interface:\n" +
+			"     want: encoding.BinaryMarshaler\n" +
+			"     have: string\n" +
+			"  missing: MarshalBinary() ([]uint8, error)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		have := types.TBin{Val: 258, Tag: "abc"}
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := RoundTripBinary(have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to survive binary round trip:\n" +
+			"    trail: type.field\n" +
+			"  encoded: 00000000  01 02" +
+			"                                             |..|\n" +
+			"\n" +
+			"expected values to be equal:\n" +
+			"  trail: type.field.Tag\n" +
+			"   want: \"abc\"\n" +
+			"   have: \"\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_RoundTripGob(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		have := map[string]int{"A": 1, "B": 2}
+
+		// --- When ---
+		err := RoundTripGob(have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		have := types.TPtrInt{Ptr: new(int)}
+
+		// --- When ---
+		err := RoundTripGob(have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.True(t, strings.HasPrefix(
+			err.Error(),
+			"expected value to survive gob round trip:\n  encoded:\n",
+		))
+		wMsg := "expected values to be equal:\n" +
+			"  trail: TPtrInt.Ptr\n" +
+			"   want: 0\n" +
+			"   have: nil"
+		affirm.True(t, strings.HasSuffix(err.Error(), wMsg))
+	})
+
+	t.Run("error - marshaling", func(t *testing.T) {
+		// --- When ---
+		err := RoundTripGob(make(chan int))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect marshaling error:\n" +
+			"  format: gob\n" +
+			"   error: gob NewTypeObject can't handle type: chan int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := RoundTripGob(make(chan int), opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect marshaling error:\n" +
+			"   trail: type.field\n" +
+			"  format: gob\n" +
+			"   error: gob NewTypeObject can't handle type: chan int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_RoundTripXML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		have := types.TIntStr{Int: 1, Str: "abc"}
+
+		// --- When ---
+		err := RoundTripXML(have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - pointer", func(t *testing.T) {
+		// --- Given ---
+		have := types.TIntStr{Int: 1, Str: " abc"}
+
+		// --- When ---
+		err := RoundTripXML(&have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - marshaling", func(t *testing.T) {
+		// --- When ---
+		err := RoundTripXML(map[string]int{})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect marshaling error:\n" +
+			"  format: XML\n" +
+			"   error: xml: unsupported type: map[string]int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"errors"
+	"iter"
+	"reflect"
+
+	"github.com/ctx42/testing/internal/core"
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// SeqHas checks iterator yields "want" value. Returns nil if it does,
+// otherwise it returns an error with a message indicating the expected value
+// and the yielded items. At most [core.SeqLimit] items are checked.
+func SeqHas[T comparable](want T, seq iter.Seq[T], opts ...Option) error {
+	items, more := seqItems(seq)
+	for _, got := range items {
+		if want == got {
+			return nil
+		}
+	}
+	ops := DefaultOptions(opts...)
+	if more {
+		return seqLimitError(ops)
+	}
+	return notice.New("expected iterator to have a value").
+		Trail(ops.Trail).
+		Want("%#v", want).
+		Append("iterator", "%s", ops.Dumper.Any(items))
+}
+
+// SeqHasNo checks iterator does not yield "want" value. Returns nil if it
+// doesn't, otherwise it returns an error with a message indicating the
+// expected value, its index and the yielded items. At most [core.SeqLimit]
+// items are checked.
+func SeqHasNo[T comparable](want T, seq iter.Seq[T], opts ...Option) error {
+	items, more := seqItems(seq)
+	for i, got := range items {
+		if want == got {
+			ops := DefaultOptions(opts...)
+			return notice.New("expected iterator not to have value").
+				Trail(ops.Trail).
+				Want("%#v", want).
+				Append("index", "%d", i).
+				Append("iterator", "%s", ops.Dumper.Any(items))
+		}
+	}
+	if more {
+		ops := DefaultOptions(opts...)
+		return seqLimitError(ops)
+	}
+	return nil
+}
+
+// SeqElementsMatch checks iterator yields the same elements as "want" slice
+// regardless of their order using [ElementsMatch]. Returns nil if it does,
+// otherwise it returns an error with a message indicating the missing and
+// unexpected values. At most [core.SeqLimit] items are collected.
+func SeqElementsMatch[T any](want []T, have iter.Seq[T], opts ...Option) error {
+	items, more := seqItems(have)
+	if more {
+		ops := DefaultOptions(opts...)
+		return seqLimitError(ops)
+	}
+	if err := ElementsMatch(want, items, opts...); err != nil {
+		var msg *notice.Notice
+		if errors.As(err, &msg) {
+			return msg.SetHeader("expected iterator to yield the same elements")
+		}
+		return err
+	}
+	return nil
+}
+
+// equalSeq checks two iterators yield equal items. Items yielded by
+// [iter.Seq2] iterators are compared as key and value pairs.
+func equalSeq(wVal, hVal reflect.Value, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	wKeys, wVals, wMore := core.Collect(wVal, core.SeqLimit)
+	hKeys, hVals, hMore := core.Collect(hVal, core.SeqLimit)
+	if wMore || hMore {
+		ops.logTrail()
+		return seqLimitError(ops)
+	}
+	if len(wVals) != len(hVals) {
+		ops.logTrail()
+		// Notice renders collected items, iterators are not called again.
+		wSeq := core.Replay(wVal.Type(), wKeys, wVals, false).Interface()
+		hSeq := core.Replay(hVal.Type(), hKeys, hVals, false).Interface()
+		return equalError(wSeq, hSeq, WithOptions(ops)).
+			Prepend("have len", "%d", len(hVals)).
+			Prepend("want len", "%d", len(wVals))
+	}
+
+	var ers []error
+	for i := range wVals {
+		iOps := ops
+		iOps.Trail = ops.arrTrail("iter", i)
+		if wKeys != nil {
+			kOps := iOps
+			kOps.Trail = iOps.structTrail("", "key")
+			if err := deepEqual(wKeys[i], hKeys[i], WithOptions(kOps)); err != nil {
+				ers = append(ers, notice.Unwrap(err)...)
+			}
+			iOps.Trail = iOps.structTrail("", "value")
+		}
+		if err := deepEqual(wVals[i], hVals[i], WithOptions(iOps)); err != nil {
+			ers = append(ers, notice.Unwrap(err)...)
+		}
+	}
+	return errors.Join(ers...)
+}
+
+// seqItems collects at most [core.SeqLimit] items yielded by the iterator.
+// Returns true as the second value when the iterator yields more items.
+func seqItems[T any](seq iter.Seq[T]) ([]T, bool) {
+	if seq == nil {
+		return nil, false
+	}
+	var items []T
+	var more bool
+	for item := range seq {
+		if len(items) == core.SeqLimit {
+			more = true
+			break
+		}
+		items = append(items, item)
+	}
+	return items, more
+}
+
+// seqLimitError returns error for iterators yielding too many items.
+func seqLimitError(ops Options) *notice.Notice {
+	const header = "expected iterator to yield at most %d items"
+	return notice.New(header, core.SeqLimit).Trail(ops.Trail)
+}
@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/core"
+)
+
+// oneShotSeq returns an iterator yielding "vals" only the first time it is
+// called, like iterators reading from a stream.
+func oneShotSeq(vals ...int) iter.Seq[int] {
+	var used bool
+	return func(yield func(int) bool) {
+		if used {
+			return
+		}
+		used = true
+		for _, v := range vals {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// infSeq is an infinite iterator.
+func infSeq(yield func(int) bool) {
+	for i := 0; yield(i); i++ {
+	}
+}
+
+func Test_SeqHas(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := SeqHas(2, slices.Values([]int{1, 2, 3}))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - infinite iterator", func(t *testing.T) {
+		// --- When ---
+		err := SeqHas(10, infSeq)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := SeqHas(42, slices.Values([]int{1, 2}))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator to have a value:\n" +
+			"      want: 42\n" +
+			"  iterator:\n" +
+			"            []int{\n" +
+			"              1,\n" +
+			"              2,\n" +
+			"            }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - nil", func(t *testing.T) {
+		// --- Given ---
+		var seq iter.Seq[int]
+
+		// --- When ---
+		err := SeqHas(42, seq)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator to have a value:\n" +
+			"      want: 42\n" +
+			"  iterator: nil"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - over limit", func(t *testing.T) {
+		// --- When ---
+		err := SeqHas(-1, infSeq)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator to yield at most 1000 items"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := SeqHas("c", slices.Values([]string{"a"}), opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator to have a value:\n" +
+			"     trail: type.field\n" +
+			"      want: \"c\"\n" +
+			"  iterator:\n" +
+			"            []string{\n" +
+			"              \"a\",\n" +
+			"            }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_SeqHasNo(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := SeqHasNo(4, slices.Values([]int{1, 2, 3}))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - nil", func(t *testing.T) {
+		// --- Given ---
+		var seq iter.Seq[int]
+
+		// --- When ---
+		err := SeqHasNo(4, seq)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := SeqHasNo(2, slices.Values([]int{1, 2, 3}))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator not to have value:\n" +
+			"      want: 2\n" +
+			"     index: 1\n" +
+			"  iterator:\n" +
+			"            []int{\n" +
+			"              1,\n" +
+			"              2,\n" +
+			"              3,\n" +
+			"            }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - over limit", func(t *testing.T) {
+		// --- When ---
+		err := SeqHasNo(-1, infSeq)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator to yield at most 1000 items"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := SeqHasNo(1, slices.Values([]int{1}), opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator not to have value:\n" +
+			"     trail: type.field\n" +
+			"      want: 1\n" +
+			"     index: 0\n" +
+			"  iterator:\n" +
+			"            []int{\n" +
+			"              1,\n" +
+			"            }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_SeqElementsMatch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := SeqElementsMatch([]int{1, 2, 3}, slices.Values([]int{3, 1, 2}))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := SeqElementsMatch([]int{1, 2}, slices.Values([]int{2, 2}))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator to yield the same elements:\n" +
+			"     missing:\n" +
+			"              []int{\n" +
+			"                1,\n" +
+			"              }\n" +
+			"  unexpected:\n" +
+			"              []int{\n" +
+			"                2,\n" +
+			"              }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - over limit", func(t *testing.T) {
+		// --- When ---
+		err := SeqElementsMatch([]int{1}, infSeq)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator to yield at most 1000 items"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := SeqElementsMatch([]int{1}, slices.Values([]int{}), opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected iterator to yield the same elements:\n" +
+			"    trail: type.field\n" +
+			"  missing:\n" +
+			"           []int{\n" +
+			"             1,\n" +
+			"           }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_seqItems(t *testing.T) {
+	t.Run("all items", func(t *testing.T) {
+		// --- When ---
+		items, more := seqItems(slices.Values([]int{1, 2}))
+
+		// --- Then ---
+		affirm.DeepEqual(t, []int{1, 2}, items)
+		affirm.False(t, more)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		// --- When ---
+		items, more := seqItems[int](nil)
+
+		// --- Then ---
+		affirm.Nil(t, items)
+		affirm.False(t, more)
+	})
+
+	t.Run("over limit", func(t *testing.T) {
+		// --- When ---
+		items, more := seqItems(infSeq)
+
+		// --- Then ---
+		affirm.Equal(t, core.SeqLimit, len(items))
+		affirm.True(t, more)
+	})
+}
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// InlineSnapshot checks "have" rendered with [Options.Dumper] is equal to the
+// "want" snapshot. Returns nil if it's, otherwise it returns an error with a
+// message indicating the expected and actual values.
+func InlineSnapshot(want string, have any, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	hStr := ops.Dumper.Any(have)
+	if want == hStr {
+		return nil
+	}
+	return notice.New("expected value to match inline snapshot").
+		Trail(ops.Trail).
+		Want("%s", want).
+		Have("%s", hStr)
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+	"github.com/ctx42/testing/pkg/dump"
+)
+
+func Test_InlineSnapshot(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := "{\n" +
+			"  Int: 1,\n" +
+			"  Str: \"abc\",\n" +
+			"  Tim: \"0001-01-01T00:00:00Z\",\n" +
+			"  Dur: \"0s\",\n" +
+			"  Loc: nil,\n" +
+			"  TAp: nil,\n" +
+			"}"
+		have := types.TA{Int: 1, Str: "abc"}
+
+		// --- When ---
+		err := InlineSnapshot(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal with dumper options", func(t *testing.T) {
+		// --- Given ---
+		opt := WithDumper(dump.WithFlat)
+
+		// --- When ---
+		err := InlineSnapshot("[]int{1, 2}", []int{1, 2}, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := InlineSnapshot("\"abc\"", "xyz", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to match inline snapshot:\n" +
+			"  trail: type.field\n" +
+			"   want: \"abc\"\n" +
+			"   have: \"xyz\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal multi line", func(t *testing.T) {
+		// --- When ---
+		err := InlineSnapshot("", []int{1, 2})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected value to match inline snapshot:\n" +
+			"  want: \n" +
+			"  have:\n" +
+			"        []int{\n" +
+			"          1,\n" +
+			"          2,\n" +
+			"        }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := InlineSnapshot(`"id: <uuid>"`, "id: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a", opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
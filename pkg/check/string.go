@@ -4,7 +4,11 @@
 package check
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/ctx42/testing/pkg/notice"
 )
@@ -13,10 +17,11 @@ import (
 // otherwise returns an error with a message indicating the expected and actual
 // values.
 func Contain(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	want, have = ops.Dumper.Scrub(want), ops.Dumper.Scrub(have)
 	if strings.Contains(have, want) {
 		return nil
 	}
-	ops := DefaultOptions(opts...)
 	return notice.New("expected string to contain substring").
 		Trail(ops.Trail).
 		Append("string", "%q", have).
@@ -27,8 +32,9 @@ func Contain(want, have string, opts ...Option) error {
 // otherwise returns an error with a message indicating the expected and actual
 // values.
 func NotContain(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	want, have = ops.Dumper.Scrub(want), ops.Dumper.Scrub(have)
 	if strings.Contains(have, want) {
-		ops := DefaultOptions(opts...)
 		return notice.New("expected string not to contain substring").
 			Trail(ops.Trail).
 			Append("string", "%q", have).
@@ -36,3 +42,388 @@ func NotContain(want, have string, opts ...Option) error {
 	}
 	return nil
 }
+
+// ContainAll checks all strings in "want" are substrings of "have". Returns
+// nil if they are, otherwise returns an error with a message listing the
+// missing substrings.
+func ContainAll(want []string, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	have = ops.Dumper.Scrub(have)
+	var missing []string
+	for _, sub := range ops.scrubStrings(want) {
+		if !strings.Contains(have, sub) {
+			missing = append(missing, sub)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return notice.New("expected string to contain all substrings").
+		Trail(ops.Trail).
+		Append("string", "%q", have).
+		Append("missing", "%s", ops.Dumper.Any(missing))
+}
+
+// ContainAny checks at least one of the strings in "want" is a substring of
+// "have". Returns nil if it is, otherwise returns an error with a message
+// indicating the expected and actual values.
+func ContainAny(want []string, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	want, have = ops.scrubStrings(want), ops.Dumper.Scrub(have)
+	for _, sub := range want {
+		if strings.Contains(have, sub) {
+			return nil
+		}
+	}
+	return notice.New("expected string to contain any of the substrings").
+		Trail(ops.Trail).
+		Append("string", "%q", have).
+		Append("substrings", "%s", ops.Dumper.Any(want))
+}
+
+// HasPrefix checks "have" begins with "want". Returns nil if it does,
+// otherwise returns an error with a message indicating the expected and actual
+// values.
+func HasPrefix(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	want, have = ops.Dumper.Scrub(want), ops.Dumper.Scrub(have)
+	if strings.HasPrefix(have, want) {
+		return nil
+	}
+	wOff, hOff := firstDiff(want, have, equalRune)
+	msg := notice.New("expected string to have prefix").
+		Trail(ops.Trail).
+		Append("prefix", "%q", want).
+		Have("%q", have)
+	return appendDiff(msg, want, have, wOff, hOff)
+}
+
+// HasSuffix checks "have" ends with "want". Returns nil if it does, otherwise
+// returns an error with a message indicating the expected and actual values.
+func HasSuffix(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	want, have = ops.Dumper.Scrub(want), ops.Dumper.Scrub(have)
+	if strings.HasSuffix(have, want) {
+		return nil
+	}
+	wOff, hOff := lastDiff(want, have)
+	msg := notice.New("expected string to have suffix").
+		Trail(ops.Trail).
+		Append("suffix", "%q", want).
+		Have("%q", have).
+		Append("offset", "%d", max(hOff, 0))
+	if isSingleLine(want) && isSingleLine(have) {
+		qHave, col := quoteVisible(have, hOff)
+		_ = msg.Append("diff", "%s", qHave+"\n"+strings.Repeat(" ", col)+"^")
+	}
+	return appendRunes(msg, want, have, wOff, hOff)
+}
+
+// EqualFold checks "want" and "have" are equal under simple Unicode
+// case-folding, which is a more general form of case-insensitivity. Returns
+// nil if they are, otherwise returns an error with a message indicating the
+// expected and actual values.
+func EqualFold(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	want, have = ops.Dumper.Scrub(want), ops.Dumper.Scrub(have)
+	if strings.EqualFold(want, have) {
+		return nil
+	}
+	wOff, hOff := firstDiff(want, have, foldRune)
+	msg := notice.New("expected strings to be equal ignoring case").
+		Trail(ops.Trail).
+		Want("%q", want).
+		Have("%q", have)
+	return appendDiff(msg, want, have, wOff, hOff)
+}
+
+// EqualTrimmed checks "want" and "have" are equal after removing leading and
+// trailing white space from both of them. Returns nil if they are, otherwise
+// returns an error with a message indicating the expected and actual values.
+// The offset is reported relative to the trimmed "have" string.
+func EqualTrimmed(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	want, have = ops.Dumper.Scrub(want), ops.Dumper.Scrub(have)
+	wTrim, hTrim := strings.TrimSpace(want), strings.TrimSpace(have)
+	if wTrim == hTrim {
+		return nil
+	}
+	wOff, hOff := firstDiff(wTrim, hTrim, equalRune)
+	msg := notice.New("expected strings to be equal ignoring leading and "+
+		"trailing white space").
+		Trail(ops.Trail).
+		Want("%q", want).
+		Have("%q", have)
+	return appendDiff(msg, wTrim, hTrim, wOff, hOff)
+}
+
+// EqualIgnoringWhitespace checks "want" and "have" are equal after removing
+// all white space characters from both of them. Returns nil if they are,
+// otherwise returns an error with a message indicating the expected and actual
+// values. The offset is reported relative to the "have" string with the white
+// space removed.
+func EqualIgnoringWhitespace(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	want, have = ops.Dumper.Scrub(want), ops.Dumper.Scrub(have)
+	wStrip, hStrip := stripSpace(want), stripSpace(have)
+	if wStrip == hStrip {
+		return nil
+	}
+	wOff, hOff := firstDiff(wStrip, hStrip, equalRune)
+	msg := notice.New("expected strings to be equal ignoring white space").
+		Trail(ops.Trail).
+		Want("%q", want).
+		Have("%q", have)
+	return appendDiff(msg, wStrip, hStrip, wOff, hOff)
+}
+
+// LinesEqual checks "want" and "have" consist of the same lines. The "\r\n"
+// and "\r" line endings are treated the same way as "\n". Returns nil if they
+// are equal, otherwise returns an error with a message indicating the first
+// differing line.
+func LinesEqual(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	want, have = ops.Dumper.Scrub(want), ops.Dumper.Scrub(have)
+	wLines, hLines := splitLines(want), splitLines(have)
+	for i := 0; i < max(len(wLines), len(hLines)); i++ {
+		if i < len(wLines) && i < len(hLines) && wLines[i] == hLines[i] {
+			continue
+		}
+
+		msg := notice.New("expected strings to have equal lines").
+			Trail(ops.Trail).
+			Append("line", "%d", i+1)
+		if i >= len(wLines) || i >= len(hLines) {
+			return msg.
+				Append("want lines", "%d", len(wLines)).
+				Append("have lines", "%d", len(hLines))
+		}
+		wOff, hOff := firstDiff(wLines[i], hLines[i], equalRune)
+		_ = msg.Want("%q", wLines[i]).Have("%q", hLines[i])
+		return appendDiff(msg, wLines[i], hLines[i], wOff, hOff)
+	}
+	return nil
+}
+
+// scrubStrings returns a copy of the slice with [Options.Dumper] scrubbers
+// applied to each string.
+func (ops Options) scrubStrings(strs []string) []string {
+	if len(ops.Dumper.Scrubbers) == 0 {
+		return strs
+	}
+	scrubbed := make([]string, len(strs))
+	for i, str := range strs {
+		scrubbed[i] = ops.Dumper.Scrub(str)
+	}
+	return scrubbed
+}
+
+// equalRune returns true if runes are equal.
+func equalRune(a, b rune) bool { return a == b }
+
+// foldRune returns true if runes are equal under simple Unicode case-folding.
+func foldRune(a, b rune) bool {
+	if a == b {
+		return true
+	}
+	for r := unicode.SimpleFold(a); r != a; r = unicode.SimpleFold(r) {
+		if r == b {
+			return true
+		}
+	}
+	return false
+}
+
+// sameRune returns true if runes "wr" and "hr" decoded from "wEnc" and "hEnc"
+// encodings are equal according to "eq" function. Invalid UTF-8 bytes, which
+// all decode to [utf8.RuneError], are compared as raw bytes.
+func sameRune(wr, hr rune, wEnc, hEnc string, eq func(a, b rune) bool) bool {
+	if isInvalidRune(wr, len(wEnc)) || isInvalidRune(hr, len(hEnc)) {
+		return wEnc == hEnc
+	}
+	return eq(wr, hr)
+}
+
+// isInvalidRune returns true if rune of given encoded size represents an
+// invalid UTF-8 byte.
+func isInvalidRune(r rune, size int) bool {
+	return r == utf8.RuneError && size == 1
+}
+
+// firstDiff returns byte offsets of the first rune in "want" and "have" which
+// is different according to "eq" function. When one string is a prefix of the
+// other the offsets point to the end of the shorter string.
+func firstDiff(want, have string, eq func(a, b rune) bool) (int, int) {
+	var wOff, hOff int
+	for wOff < len(want) && hOff < len(have) {
+		wr, wSize := utf8.DecodeRuneInString(want[wOff:])
+		hr, hSize := utf8.DecodeRuneInString(have[hOff:])
+		wEnc, hEnc := want[wOff:wOff+wSize], have[hOff:hOff+hSize]
+		if !sameRune(wr, hr, wEnc, hEnc, eq) {
+			break
+		}
+		wOff += wSize
+		hOff += hSize
+	}
+	return wOff, hOff
+}
+
+// lastDiff returns byte offsets of the last rune in "want" and "have" which
+// is different when comparing both strings from the end. When one of the
+// strings is exhausted its offset is -1.
+func lastDiff(want, have string) (int, int) {
+	wEnd, hEnd := len(want), len(have)
+	for wEnd > 0 && hEnd > 0 {
+		wr, wSize := utf8.DecodeLastRuneInString(want[:wEnd])
+		hr, hSize := utf8.DecodeLastRuneInString(have[:hEnd])
+		wEnc, hEnc := want[wEnd-wSize:wEnd], have[hEnd-hSize:hEnd]
+		if !sameRune(wr, hr, wEnc, hEnc, equalRune) {
+			return wEnd - wSize, hEnd - hSize
+		}
+		wEnd -= wSize
+		hEnd -= hSize
+	}
+	wOff, hOff := -1, -1
+	if wEnd > 0 {
+		_, size := utf8.DecodeLastRuneInString(want[:wEnd])
+		wOff = wEnd - size
+	}
+	if hEnd > 0 {
+		_, size := utf8.DecodeLastRuneInString(have[:hEnd])
+		hOff = hEnd - size
+	}
+	return wOff, hOff
+}
+
+// appendDiff appends the "offset" row with byte offset of the first differing
+// rune in "have" to the message. When both strings are single line strings it
+// also appends a "diff" row with both strings and the caret pointing to the
+// differing rune. When one of the differing runes is invisible or may be
+// confused with other rune, the "runes" row describing both runes is
+// appended.
+func appendDiff(msg *notice.Notice, want, have string, wOff, hOff int) *notice.Notice {
+	_ = msg.Append("offset", "%d", max(hOff, 0))
+	if isSingleLine(want) && isSingleLine(have) {
+		qWant, _ := quoteVisible(want, wOff)
+		qHave, col := quoteVisible(have, hOff)
+		diff := qWant + "\n" + qHave + "\n" + strings.Repeat(" ", col) + "^"
+		_ = msg.Append("diff", "%s", diff)
+	}
+	return appendRunes(msg, want, have, wOff, hOff)
+}
+
+// appendRunes appends the "runes" row describing runes at given offsets when
+// at least one of them is not a visible ASCII character.
+func appendRunes(msg *notice.Notice, want, have string, wOff, hOff int) *notice.Notice {
+	wr, hr := runeAt(want, wOff), runeAt(have, hOff)
+	if isVisibleRune(wr) && isVisibleRune(hr) {
+		return msg
+	}
+	return msg.Append(
+		"runes",
+		"want %s, have %s",
+		describeRuneAt(want, wOff),
+		describeRuneAt(have, hOff),
+	)
+}
+
+// isSingleLine returns true if string has no new line characters.
+func isSingleLine(s string) bool {
+	return !strings.ContainsAny(s, "\r\n")
+}
+
+// isVisibleRune returns true for printable ASCII characters other than space
+// and for -1 representing no rune.
+func isVisibleRune(r rune) bool {
+	return r < 0 || (r > ' ' && r <= '~')
+}
+
+// runeAt returns rune at byte offset "off" in "s". Returns -1 if the offset
+// is outside the string.
+func runeAt(s string, off int) rune {
+	if off < 0 || off >= len(s) {
+		return -1
+	}
+	r, _ := utf8.DecodeRuneInString(s[off:])
+	return r
+}
+
+// describeRuneAt returns description of the rune at byte offset "off" in "s".
+// Invalid UTF-8 bytes are described by their value.
+func describeRuneAt(s string, off int) string {
+	if off >= 0 && off < len(s) {
+		if r, size := utf8.DecodeRuneInString(s[off:]); isInvalidRune(r, size) {
+			return fmt.Sprintf(`invalid UTF-8 byte \x%02x`, s[off])
+		}
+	}
+	return describeRune(runeAt(s, off))
+}
+
+// describeRune returns description of a rune with its Unicode code point.
+func describeRune(r rune) string {
+	if r < 0 {
+		return "<none>"
+	}
+	return fmt.Sprintf("%U %s", r, strconv.QuoteRune(r))
+}
+
+// quoteVisible returns double-quoted Go string literal representing "s" the
+// same way as [strconv.Quote] does, but additionally marks trailing spaces at
+// the end of each line with "⎵" character. It also returns the column (in
+// runes) of the rune at byte offset "off" in the returned string. For negative
+// offsets the column of the opening quote is returned and for offsets past the
+// string end the column of the closing quote.
+func quoteVisible(s string, off int) (string, int) {
+	buf := make([]byte, 0, len(s)+2)
+	buf = append(buf, '"')
+	cnt, col := 1, 0
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if i == off {
+			col = cnt
+		}
+		var lit string
+		switch {
+		case r == ' ' && isTrailingSpace(s, i):
+			lit = "⎵"
+		case isInvalidRune(r, size):
+			lit = fmt.Sprintf(`\x%02x`, s[i])
+		default:
+			lit = strconv.Quote(s[i : i+size])
+			lit = lit[1 : len(lit)-1]
+		}
+		buf = append(buf, lit...)
+		cnt += utf8.RuneCountInString(lit)
+		i += size
+	}
+	if off >= len(s) {
+		col = cnt
+	}
+	buf = append(buf, '"')
+	return string(buf), col
+}
+
+// isTrailingSpace returns true if the space at byte offset "off" is followed
+// only by spaces until the end of the line or the end of the string.
+func isTrailingSpace(s string, off int) bool {
+	rest := strings.TrimLeft(s[off:], " ")
+	return rest == "" || rest[0] == '\n' || rest[0] == '\r'
+}
+
+// stripSpace returns string with all white space characters removed.
+func stripSpace(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// splitLines splits string into lines treating "\r\n", "\r" and "\n" as line
+// endings.
+func splitLines(s string) []string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+	return strings.Split(s, "\n")
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/dump"
 )
 
 func Test_Contain(t *testing.T) {
@@ -26,6 +27,17 @@ func Test_Contain(t *testing.T) {
 			"  substring: \"abc\""
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := Contain("id: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a", "user id: 0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
 }
 
 func Test_Contain_success_tabular(t *testing.T) {
@@ -94,6 +106,21 @@ func Test_NotContain(t *testing.T) {
 			"  substring: \"abc\""
 		affirm.Equal(t, wMsg, err.Error())
 	})
+
+	t.Run("error scrubbers", func(t *testing.T) {
+		// --- Given ---
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := NotContain("id: <uuid>", "id: 0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string not to contain substring:\n" +
+			"     string: \"id: <uuid>\"\n" +
+			"  substring: \"id: <uuid>\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
 }
 
 func Test_NotContain_success_tabular(t *testing.T) {
@@ -144,3 +171,770 @@ func Test_NotContain_error_tabular(t *testing.T) {
 		})
 	}
 }
+
+func Test_ContainAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := ContainAll([]string{"abc", "ghi"}, "abc def ghi")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - empty want", func(t *testing.T) {
+		// --- When ---
+		err := ContainAll(nil, "abc")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := ContainAll([]string{"abc", "x", "y"}, "abc def")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to contain all substrings:\n" +
+			"   string: \"abc def\"\n" +
+			"  missing:\n" +
+			"           []string{\n" +
+			"             \"x\",\n" +
+			"             \"y\",\n" +
+			"           }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := ContainAll([]string{"x"}, "abc", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to contain all substrings:\n" +
+			"    trail: type.field\n" +
+			"   string: \"abc\"\n" +
+			"  missing:\n" +
+			"           []string{\n" +
+			"             \"x\",\n" +
+			"           }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := ContainAll([]string{"id: <uuid>", "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a"}, "id: 0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_ContainAny(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := ContainAny([]string{"x", "def"}, "abc def ghi")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := ContainAny([]string{"x", "y"}, "abc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to contain any of the substrings:\n" +
+			"      string: \"abc\"\n" +
+			"  substrings:\n" +
+			"              []string{\n" +
+			"                \"x\",\n" +
+			"                \"y\",\n" +
+			"              }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - empty want", func(t *testing.T) {
+		// --- When ---
+		err := ContainAny(nil, "abc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to contain any of the substrings:\n" +
+			"      string: \"abc\"\n" +
+			"  substrings: nil"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := ContainAny([]string{"x"}, "abc", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to contain any of the substrings:\n" +
+			"       trail: type.field\n" +
+			"      string: \"abc\"\n" +
+			"  substrings:\n" +
+			"              []string{\n" +
+			"                \"x\",\n" +
+			"              }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error scrubbers", func(t *testing.T) {
+		// --- Given ---
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := ContainAny([]string{"7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a x"}, "id: 0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to contain any of the substrings:\n" +
+			"      string: \"id: <uuid>\"\n" +
+			"  substrings:\n" +
+			"              []string{\n" +
+			"                \"<uuid> x\",\n" +
+			"              }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_HasPrefix(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := HasPrefix("abc", "abcdef")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := HasPrefix("abc", "abxdef")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have prefix:\n" +
+			"  prefix: \"abc\"\n" +
+			"    have: \"abxdef\"\n" +
+			"  offset: 2\n" +
+			"    diff:\n" +
+			"          \"abc\"\n" +
+			"          \"abxdef\"\n" +
+			"             ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - have shorter than prefix", func(t *testing.T) {
+		// --- When ---
+		err := HasPrefix("abc", "ab")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have prefix:\n" +
+			"  prefix: \"abc\"\n" +
+			"    have: \"ab\"\n" +
+			"  offset: 2\n" +
+			"    diff:\n" +
+			"          \"abc\"\n" +
+			"          \"ab\"\n" +
+			"             ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - escaped and multibyte runes", func(t *testing.T) {
+		// --- When ---
+		err := HasPrefix("a\tżb", "a\tżc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have prefix:\n" +
+			"  prefix: \"a\\tżb\"\n" +
+			"    have: \"a\\tżc\"\n" +
+			"  offset: 4\n" +
+			"    diff:\n" +
+			"          \"a\\tżb\"\n" +
+			"          \"a\\tżc\"\n" +
+			"               ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - multi line strings have no diff", func(t *testing.T) {
+		// --- When ---
+		err := HasPrefix("a\nb", "a\nc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have prefix:\n" +
+			"  prefix: \"a\\nb\"\n" +
+			"    have: \"a\\nc\"\n" +
+			"  offset: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := HasPrefix("b", "abc", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have prefix:\n" +
+			"   trail: type.field\n" +
+			"  prefix: \"b\"\n" +
+			"    have: \"abc\"\n" +
+			"  offset: 0\n" +
+			"    diff:\n" +
+			"          \"b\"\n" +
+			"          \"abc\"\n" +
+			"           ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := HasPrefix("7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a ", "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d abc", opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_HasSuffix(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := HasSuffix("def", "abcdef")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := HasSuffix("def", "abcdxf")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have suffix:\n" +
+			"  suffix: \"def\"\n" +
+			"    have: \"abcdxf\"\n" +
+			"  offset: 4\n" +
+			"    diff:\n" +
+			"          \"abcdxf\"\n" +
+			"               ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - have shorter than suffix", func(t *testing.T) {
+		// --- When ---
+		err := HasSuffix("abcdef", "ef")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have suffix:\n" +
+			"  suffix: \"abcdef\"\n" +
+			"    have: \"ef\"\n" +
+			"  offset: 0\n" +
+			"    diff:\n" +
+			"          \"ef\"\n" +
+			"          ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := HasSuffix("b", "abc", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected string to have suffix:\n" +
+			"   trail: type.field\n" +
+			"  suffix: \"b\"\n" +
+			"    have: \"abc\"\n" +
+			"  offset: 2\n" +
+			"    diff:\n" +
+			"          \"abc\"\n" +
+			"             ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := HasSuffix(" 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a", "abc 0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_EqualFold(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := EqualFold("Go ŻÓŁW", "go żółw")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := EqualFold("Hello", "hELLx")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to be equal ignoring case:\n" +
+			"    want: \"Hello\"\n" +
+			"    have: \"hELLx\"\n" +
+			"  offset: 4\n" +
+			"    diff:\n" +
+			"          \"Hello\"\n" +
+			"          \"hELLx\"\n" +
+			"               ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := EqualFold("abc", "aBd", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to be equal ignoring case:\n" +
+			"   trail: type.field\n" +
+			"    want: \"abc\"\n" +
+			"    have: \"aBd\"\n" +
+			"  offset: 2\n" +
+			"    diff:\n" +
+			"          \"abc\"\n" +
+			"          \"aBd\"\n" +
+			"             ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error scrubbers", func(t *testing.T) {
+		// --- Given ---
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := EqualFold("ID: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a", "id: 0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d!", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to be equal ignoring case:\n" +
+			"    want: \"ID: <uuid>\"\n" +
+			"    have: \"id: <uuid>!\"\n" +
+			"  offset: 10\n" +
+			"    diff:\n" +
+			"          \"ID: <uuid>\"\n" +
+			"          \"id: <uuid>!\"\n" +
+			"                     ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_EqualTrimmed(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := EqualTrimmed(" abc\n", "\tabc  ")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - inner white space is significant", func(t *testing.T) {
+		// --- When ---
+		err := EqualTrimmed("a b", "a  b")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to be equal ignoring leading and " +
+			"trailing white space:\n" +
+			"    want: \"a b\"\n" +
+			"    have: \"a  b\"\n" +
+			"  offset: 2\n" +
+			"    diff:\n" +
+			"          \"a b\"\n" +
+			"          \"a  b\"\n" +
+			"             ^\n" +
+			"   runes: want U+0062 'b', have U+0020 ' '"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := EqualTrimmed(" abc ", "abd\t", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to be equal ignoring leading and " +
+			"trailing white space:\n" +
+			"   trail: type.field\n" +
+			"    want: \" abc \"\n" +
+			"    have: \"abd\\t\"\n" +
+			"  offset: 2\n" +
+			"    diff:\n" +
+			"          \"abc\"\n" +
+			"          \"abd\"\n" +
+			"             ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := EqualTrimmed(" 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a ", "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d\n", opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_EqualIgnoringWhitespace(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := EqualIgnoringWhitespace("a b\nc", " a\tbc ")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := EqualIgnoringWhitespace("a b c", "a\tb d", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to be equal ignoring white space:\n" +
+			"   trail: type.field\n" +
+			"    want: \"a b c\"\n" +
+			"    have: \"a\\tb d\"\n" +
+			"  offset: 2\n" +
+			"    diff:\n" +
+			"          \"abc\"\n" +
+			"          \"abd\"\n" +
+			"             ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := EqualIgnoringWhitespace("id: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a", "id:0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_LinesEqual(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := LinesEqual("a\nb\nc", "a\r\nb\rc")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - different line", func(t *testing.T) {
+		// --- When ---
+		err := LinesEqual("a\r\nbcd\nc", "a\nbxd\nc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to have equal lines:\n" +
+			"    line: 2\n" +
+			"    want: \"bcd\"\n" +
+			"    have: \"bxd\"\n" +
+			"  offset: 1\n" +
+			"    diff:\n" +
+			"          \"bcd\"\n" +
+			"          \"bxd\"\n" +
+			"            ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - different number of lines", func(t *testing.T) {
+		// --- When ---
+		err := LinesEqual("a\r\nb", "a\nb\nc")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to have equal lines:\n" +
+			"        line: 3\n" +
+			"  want lines: 2\n" +
+			"  have lines: 3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := LinesEqual("a", "b", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to have equal lines:\n" +
+			"   trail: type.field\n" +
+			"    line: 1\n" +
+			"    want: \"a\"\n" +
+			"    have: \"b\"\n" +
+			"  offset: 0\n" +
+			"    diff:\n" +
+			"          \"a\"\n" +
+			"          \"b\"\n" +
+			"           ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		want := "id: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a\nname: Bob"
+		have := "id: 0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d\nname: Bob"
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := LinesEqual(want, have, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal with scrubbers", func(t *testing.T) {
+		// --- Given ---
+		want := "id: <uuid>\nname: Bob"
+		have := "id: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a\nname: Joe"
+		opt := WithScrubbers(dump.ScrubUUID)
+
+		// --- When ---
+		err := LinesEqual(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected strings to have equal lines:\n" +
+			"    line: 2\n" +
+			"    want: \"name: Bob\"\n" +
+			"    have: \"name: Joe\"\n" +
+			"  offset: 6\n" +
+			"    diff:\n" +
+			"          \"name: Bob\"\n" +
+			"          \"name: Joe\"\n" +
+			"                 ^"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_firstDiff_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		want  string
+		have  string
+		wWOff int
+		wHOff int
+	}{
+		{"equal", "abc", "abc", 3, 3},
+		{"empty", "", "", 0, 0},
+		{"first", "abc", "xbc", 0, 0},
+		{"middle", "abc", "axc", 1, 1},
+		{"have longer", "ab", "abc", 2, 2},
+		{"want longer", "abc", "ab", 2, 2},
+		{"multibyte", "żab", "żac", 3, 3},
+		{"invalid bytes", "ab\xff", "ab\xfe", 2, 2},
+		{"equal invalid bytes", "a\xffb", "a\xffc", 2, 2},
+		{"invalid byte and replacement rune", "a\xff", "a\ufffd", 1, 1},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			wOff, hOff := firstDiff(tc.want, tc.have, equalRune)
+
+			// --- Then ---
+			affirm.Equal(t, tc.wWOff, wOff)
+			affirm.Equal(t, tc.wHOff, hOff)
+		})
+	}
+}
+
+func Test_lastDiff_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		want  string
+		have  string
+		wWOff int
+		wHOff int
+	}{
+		{"equal", "abc", "abc", -1, -1},
+		{"last", "abc", "abx", 2, 2},
+		{"middle", "abc", "xxbc", 0, 1},
+		{"have exhausted", "abc", "bc", 0, -1},
+		{"want exhausted", "bc", "abc", -1, 0},
+		{"multibyte", "ażc", "ażcc", 1, 3},
+		{"invalid bytes", "a\xffb", "a\xfeb", 1, 1},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			wOff, hOff := lastDiff(tc.want, tc.have)
+
+			// --- Then ---
+			affirm.Equal(t, tc.wWOff, wOff)
+			affirm.Equal(t, tc.wHOff, hOff)
+		})
+	}
+}
+
+func Test_quoteVisible_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		s    string
+		off  int
+		want string
+		col  int
+	}{
+		{"empty", "", 0, `""`, 1},
+		{"simple", "abc", 1, `"abc"`, 2},
+		{"negative offset", "abc", -1, `"abc"`, 0},
+		{"offset past end", "abc", 3, `"abc"`, 4},
+		{"inner space", "a b", 2, `"a b"`, 3},
+		{"trailing space", "ab  ", 3, `"ab⎵⎵"`, 4},
+		{"trailing space before new line", "a \nb ", 4, `"a⎵\nb⎵"`, 6},
+		{"trailing space before CR", "a \r\n", 2, `"a⎵\r\n"`, 3},
+		{"escapes", "\t\"x", 2, `"\t\"x"`, 5},
+		{"nbsp", "a\u00a0b", 3, `"a\u00a0b"`, 8},
+		{"multibyte", "żb", 2, `"żb"`, 2},
+		{"invalid utf8", "a\xffb", 2, `"a\xffb"`, 6},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, col := quoteVisible(tc.s, tc.off)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+			affirm.Equal(t, tc.col, col)
+		})
+	}
+}
+
+func Test_describeRune_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		r    rune
+		want string
+	}{
+		{"none", -1, "<none>"},
+		{"ascii", 'a', "U+0061 'a'"},
+		{"space", ' ', "U+0020 ' '"},
+		{"tab", '\t', `U+0009 '\t'`},
+		{"nbsp", '\u00a0', `U+00A0 '\u00a0'`},
+		{"zero width space", '\u200b', `U+200B '\u200b'`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := describeRune(tc.r)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_HasPrefix_invisible_runes(t *testing.T) {
+	// --- When ---
+	err := HasPrefix("ab\u00a0", "ab c")
+
+	// --- Then ---
+	affirm.NotNil(t, err)
+	wMsg := "expected string to have prefix:\n" +
+		"  prefix: \"ab\\u00a0\"\n" +
+		"    have: \"ab c\"\n" +
+		"  offset: 2\n" +
+		"    diff:\n" +
+		"          \"ab\\u00a0\"\n" +
+		"          \"ab c\"\n" +
+		"             ^\n" +
+		"   runes: want U+00A0 '\\u00a0', have U+0020 ' '"
+	affirm.Equal(t, wMsg, err.Error())
+}
+
+func Test_HasPrefix_invalid_UTF8(t *testing.T) {
+	// --- When ---
+	err := HasPrefix("a\xff", "a\xfe")
+
+	// --- Then ---
+	affirm.NotNil(t, err)
+	wMsg := "expected string to have prefix:\n" +
+		"  prefix: \"a\\xff\"\n" +
+		"    have: \"a\\xfe\"\n" +
+		"  offset: 1\n" +
+		"    diff:\n" +
+		"          \"a\\xff\"\n" +
+		"          \"a\\xfe\"\n" +
+		"            ^\n" +
+		"   runes: want invalid UTF-8 byte \\xff, " +
+		"have invalid UTF-8 byte \\xfe"
+	affirm.Equal(t, wMsg, err.Error())
+}
+
+func Test_HasSuffix_invisible_runes(t *testing.T) {
+	// --- When ---
+	err := HasSuffix("c", "abc ")
+
+	// --- Then ---
+	affirm.NotNil(t, err)
+	wMsg := "expected string to have suffix:\n" +
+		"  suffix: \"c\"\n" +
+		"    have: \"abc \"\n" +
+		"  offset: 3\n" +
+		"    diff:\n" +
+		"          \"abc⎵\"\n" +
+		"              ^\n" +
+		"   runes: want U+0063 'c', have U+0020 ' '"
+	affirm.Equal(t, wMsg, err.Error())
+}
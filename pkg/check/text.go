@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// Markers used in [TextMatch] patterns.
+const (
+	// TextAny matches any run of characters in a line. When it is the only
+	// thing in the pattern line it matches zero or more lines.
+	TextAny = "..."
+
+	// TextOptional when used as the pattern line prefix marks the line as
+	// optional.
+	TextOptional = "[[?]]"
+
+	// textRegexpStart and textRegexpEnd delimit regular expression embedded
+	// in the pattern line.
+	textRegexpStart = "[[re:"
+	textRegexpEnd   = "]]"
+)
+
+// TextMatch checks "have" text matches the doctest style "pattern". Returns
+// nil if it does, otherwise it returns an error with a message indicating the
+// first pattern line which could not be matched and the nearest candidate
+// line in "have".
+//
+// The pattern is matched line by line, and every pattern line must match the
+// whole "have" line. The "\r\n" and "\r" line endings are treated the same
+// way as "\n". The pattern lines may use following syntax:
+//
+//   - "..." matches any run of characters in a line,
+//   - "..." as the only thing in the line matches zero or more lines,
+//   - "[[re:expr]]" matches the regular expression "expr", the expression
+//     ends at the last two brackets of the first run of "]" characters, so
+//     "[[re:[0-9]]]" matches a digit,
+//   - "[[?]]" prefix marks the line as optional.
+//
+// Example:
+//
+//	pattern := "" +
+//		"Starting server...\n" +
+//		"[[?]]warning: ...\n" +
+//		"listening on port [[re:\\d+]]\n" +
+//		"..."
+func TextMatch(pattern, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	pLines, err := parseTextPattern(pattern)
+	if err != nil {
+		return notice.New("expected valid text pattern").
+			Trail(ops.Trail).
+			Append("error", "%s", err)
+	}
+
+	mch := newTextMatcher(pLines, splitLines(ops.Dumper.Scrub(have)))
+	if mch.match(0, 0) {
+		return nil
+	}
+
+	msg := notice.New("expected text to match pattern").Trail(ops.Trail)
+	if mch.failP == len(pLines) {
+		_ = msg.Append("pattern line", "%s", "<end of pattern>")
+	} else {
+		pLine := pLines[mch.failP]
+		_ = msg.
+			Append("pattern line", "%d", pLine.num).
+			Append("pattern", "%q", pLine.text)
+	}
+	if mch.failH >= len(mch.have) {
+		return msg.Append("have line", "%s", "<end of text>")
+	}
+	return msg.
+		Append("have line", "%d", mch.failH+1).
+		Have("%q", mch.have[mch.failH])
+}
+
+// textLine represents parsed [TextMatch] pattern line.
+type textLine struct {
+	num      int            // The pattern line number (one based).
+	text     string         // The pattern line as written.
+	literal  string         // The literal prefix of the line.
+	rx       *regexp.Regexp // Line matcher.
+	anyLines bool           // The line matches zero or more lines.
+	optional bool           // The line is optional.
+}
+
+// parseTextPattern parses [TextMatch] pattern into lines.
+func parseTextPattern(pattern string) ([]textLine, error) {
+	var lines []textLine
+	for i, text := range splitLines(pattern) {
+		line := textLine{num: i + 1, text: text}
+		if text == TextAny {
+			line.anyLines = true
+			lines = append(lines, line)
+			continue
+		}
+		if strings.HasPrefix(text, TextOptional) {
+			line.optional = true
+			text = text[len(TextOptional):]
+		}
+		expr, literal, err := textLineRegexp(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.num, err)
+		}
+		if line.rx, err = regexp.Compile(expr); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.num, err)
+		}
+		line.literal = literal
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// textLineRegexp returns anchored regular expression for a pattern line and
+// the literal text the line starts with.
+func textLineRegexp(text string) (string, string, error) {
+	var literal strings.Builder
+	var inLiteral = true
+
+	var buf strings.Builder
+	buf.WriteString("^")
+	for text != "" {
+		switch {
+		case strings.HasPrefix(text, TextAny):
+			buf.WriteString("(?:.*)")
+			text = text[len(TextAny):]
+			inLiteral = false
+
+		case strings.HasPrefix(text, textRegexpStart):
+			end := strings.Index(text, textRegexpEnd)
+			if end < 0 {
+				return "", "", errors.New("unclosed " + textRegexpStart)
+			}
+			for end+len(textRegexpEnd) < len(text) &&
+				text[end+len(textRegexpEnd)] == ']' {
+				end++
+			}
+			buf.WriteString("(?:")
+			buf.WriteString(text[len(textRegexpStart):end])
+			buf.WriteString(")")
+			text = text[end+len(textRegexpEnd):]
+			inLiteral = false
+
+		default:
+			next := strings.IndexAny(text[1:], ".[") + 1
+			if next == 0 {
+				next = len(text)
+			}
+			buf.WriteString(regexp.QuoteMeta(text[:next]))
+			if inLiteral {
+				literal.WriteString(text[:next])
+			}
+			text = text[next:]
+		}
+	}
+	buf.WriteString("$")
+	return buf.String(), literal.String(), nil
+}
+
+// textMatcher matches lines of text against pattern lines.
+type textMatcher struct {
+	pattern []textLine      // Pattern lines.
+	have    []string        // Lines to match.
+	visited map[[2]int]bool // Visited pattern and have line index pairs.
+
+	// The furthest pattern line which failed to match, the nearest candidate
+	// line and its similarity score.
+	failP, failH, failScore int
+}
+
+// newTextMatcher returns new instance of textMatcher.
+func newTextMatcher(pattern []textLine, have []string) *textMatcher {
+	return &textMatcher{
+		pattern: pattern,
+		have:    have,
+		visited: make(map[[2]int]bool),
+		failP:   -1,
+	}
+}
+
+// match returns true if pattern lines starting from index "p" match have
+// lines starting from index "h".
+func (mch *textMatcher) match(p, h int) bool {
+	if p == len(mch.pattern) {
+		if h < len(mch.have) {
+			mch.fail(p, h)
+			return false
+		}
+		return true
+	}
+	key := [2]int{p, h}
+	if mch.visited[key] {
+		return false // Already tried and failed.
+	}
+	mch.visited[key] = true
+
+	line := mch.pattern[p]
+	if line.anyLines {
+		if mch.match(p+1, h) {
+			return true
+		}
+		return h < len(mch.have) && mch.match(p, h+1)
+	}
+	if line.optional && mch.match(p+1, h) {
+		return true
+	}
+	if h < len(mch.have) && line.rx.MatchString(mch.have[h]) {
+		return mch.match(p+1, h+1)
+	}
+	mch.fail(p, h)
+	return false
+}
+
+// fail records pattern line "p" failed to match line "h".
+func (mch *textMatcher) fail(p, h int) {
+	score := -1
+	if p < len(mch.pattern) && h < len(mch.have) {
+		score = commonPrefix(mch.pattern[p].literal, mch.have[h])
+	}
+	switch {
+	case p > mch.failP:
+		mch.failP, mch.failH, mch.failScore = p, h, score
+	case p == mch.failP && score > mch.failScore:
+		mch.failH, mch.failScore = h, score
+	}
+}
+
+// commonPrefix returns the length of common prefix of two strings.
+func commonPrefix(a, b string) int {
+	var i int
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
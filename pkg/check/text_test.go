@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/dump"
+)
+
+func Test_TextMatch(t *testing.T) {
+	t.Run("success - literal", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("abc\ndef", "abc\ndef")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - empty", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("", "")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - different line endings", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("abc\ndef", "abc\r\ndef")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - any characters", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("Starting ... on port ...", "Starting server on port 80")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - any lines", func(t *testing.T) {
+		// --- Given ---
+		pattern := "start\n...\nend"
+
+		// --- Then ---
+		affirm.Nil(t, TextMatch(pattern, "start\nend"))
+		affirm.Nil(t, TextMatch(pattern, "start\na\nend"))
+		affirm.Nil(t, TextMatch(pattern, "start\na\nb\nend"))
+	})
+
+	t.Run("success - optional line", func(t *testing.T) {
+		// --- Given ---
+		pattern := "start\n[[?]]warning: ...\nend"
+
+		// --- Then ---
+		affirm.Nil(t, TextMatch(pattern, "start\nend"))
+		affirm.Nil(t, TextMatch(pattern, "start\nwarning: abc\nend"))
+	})
+
+	t.Run("success - regexp", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("port [[re:\\d+]]", "port 8080")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - regexp with character class", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("code [[re:[0-9]]]!", "code 7!")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - regexp metacharacters are literal", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("a.b [x] (y)", "a.b [x] (y)")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - any lines with backtracking", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("...\nb\n...\nb", "a\nb\nc\nb")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - regexp must match whole line", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("port [[re:\\d+]]", "port 80 open")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected text to match pattern:\n" +
+			"  pattern line: 1\n" +
+			"       pattern: \"port [[re:\\\\d+]]\"\n" +
+			"     have line: 1\n" +
+			"          have: \"port 80 open\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - nearest candidate line", func(t *testing.T) {
+		// --- Given ---
+		pattern := "" +
+			"Starting...\n" +
+			"[[?]]warning: ...\n" +
+			"listening on port [[re:\\d+]]\n" +
+			"end"
+
+		have := "" +
+			"Starting server\n" +
+			"listening on port x\n" +
+			"listening on pirt 80\n" +
+			"end"
+
+		// --- When ---
+		err := TextMatch(pattern, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected text to match pattern:\n" +
+			"  pattern line: 3\n" +
+			"       pattern: \"listening on port [[re:\\\\d+]]\"\n" +
+			"     have line: 2\n" +
+			"          have: \"listening on port x\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - end of text", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("a\nb", "a")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected text to match pattern:\n" +
+			"  pattern line: 2\n" +
+			"       pattern: \"b\"\n" +
+			"     have line: <end of text>"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - end of pattern", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("a", "a\nb")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected text to match pattern:\n" +
+			"  pattern line: <end of pattern>\n" +
+			"     have line: 2\n" +
+			"          have: \"b\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - invalid regexp", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("a\nb[[re:(]]", "a\nb")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid text pattern:\n" +
+			"  error: line 2: error parsing regexp: missing closing ): `^b(?:()$`"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - unclosed regexp", func(t *testing.T) {
+		// --- When ---
+		err := TextMatch("a[[re:x", "a")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected valid text pattern:\n" +
+			"  error: line 1: unclosed [[re:"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := TextMatch("a", "b", opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected text to match pattern:\n" +
+			"         trail: type.field\n" +
+			"  pattern line: 1\n" +
+			"       pattern: \"a\"\n" +
+			"     have line: 1\n" +
+			"          have: \"b\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		pattern := "request <uuid> started at <time>"
+		have := "request 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a started at 2020-01-02T03:04:05Z"
+		opt := WithScrubbers(dump.ScrubUUID, dump.ScrubRFC3339)
+
+		// --- When ---
+		err := TextMatch(pattern, have, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_textLineRegexp_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		text    string
+		expr    string
+		literal string
+	}{
+		{"empty", "", "^$", ""},
+		{"literal", "abc", "^abc$", "abc"},
+		{"quoted", "a.b[c]", "^a\\.b\\[c\\]$", "a.b[c]"},
+		{"any", "a...b", "^a(?:.*)b$", "a"},
+		{"regexp", "a[[re:\\d+]]b", "^a(?:\\d+)b$", "a"},
+		{"regexp with class", "a[[re:[0-9]]]b", "^a(?:[0-9])b$", "a"},
+		{"regexp nested class", "[[re:[[:digit:]]]]", "^(?:[[:digit:]])$", ""},
+		{"two regexps", "[[re:a]]-[[re:[b]]]", "^(?:a)-(?:[b])$", ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			expr, literal, err := textLineRegexp(tc.text)
+
+			// --- Then ---
+			affirm.Nil(t, err)
+			affirm.Equal(t, tc.expr, expr)
+			affirm.Equal(t, tc.literal, literal)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// Implements checks the type of "have" implements interface "I". Returns nil
+// if it does, otherwise it returns an error with a message listing methods
+// missing from the method set and methods with mismatched signatures.
+// Unexported interface methods cannot be checked and are listed separately.
+//
+// Example:
+//
+//	err := check.Implements[io.Reader](have)
+func Implements[I any](have any, opts ...Option) error {
+	iTyp := reflect.TypeFor[I]()
+	if iTyp.Kind() != reflect.Interface {
+		ops := DefaultOptions(opts...)
+		return notice.New("expected interface type parameter").
+			Trail(ops.Trail).
+			Append("got type", "%s", iTyp)
+	}
+	hTyp := reflect.TypeOf(have)
+	if hTyp != nil && hTyp.Implements(iTyp) {
+		return nil
+	}
+
+	ops := DefaultOptions(opts...)
+	msg := notice.New("expected type to implement interface").
+		Trail(ops.Trail).
+		Want("%s", iTyp).
+		Have("%T", have)
+	return appendMethodSet(msg, iTyp, hTyp)
+}
+
+// NotImplements checks the type of "have" does not implement interface "I".
+// Returns nil if it doesn't, otherwise it returns an error with a message
+// indicating the expected and actual types.
+func NotImplements[I any](have any, opts ...Option) error {
+	iTyp := reflect.TypeFor[I]()
+	if iTyp.Kind() != reflect.Interface {
+		ops := DefaultOptions(opts...)
+		return notice.New("expected interface type parameter").
+			Trail(ops.Trail).
+			Append("got type", "%s", iTyp)
+	}
+	hTyp := reflect.TypeOf(have)
+	if hTyp == nil || !hTyp.Implements(iTyp) {
+		return nil
+	}
+
+	ops := DefaultOptions(opts...)
+	return notice.New("expected type not to implement interface").
+		Trail(ops.Trail).
+		Want("%s", iTyp).
+		Have("%T", have)
+}
+
+// AssignableTo checks the value "have" is assignable to a variable of type
+// "T". Returns nil if it is, otherwise it returns an error with a message
+// indicating the expected and actual types. When "T" is an interface, the
+// message lists methods missing from the method set and methods with
+// mismatched signatures.
+func AssignableTo[T any](have any, opts ...Option) error {
+	tTyp := reflect.TypeFor[T]()
+	hTyp := reflect.TypeOf(have)
+	if hTyp == nil {
+		if isNillable(tTyp) {
+			return nil
+		}
+	} else if hTyp.AssignableTo(tTyp) {
+		return nil
+	}
+
+	ops := DefaultOptions(opts...)
+	msg := notice.New("expected type to be assignable").
+		Trail(ops.Trail).
+		Want("%s", tTyp).
+		Have("%T", have)
+	if tTyp.Kind() == reflect.Interface {
+		return appendMethodSet(msg, tTyp, hTyp)
+	}
+	return msg
+}
+
+// ConvertibleTo checks the value "have" is convertible to type "T". Returns
+// nil if it is, otherwise it returns an error with a message indicating the
+// expected and actual types.
+func ConvertibleTo[T any](have any, opts ...Option) error {
+	tTyp := reflect.TypeFor[T]()
+	hTyp := reflect.TypeOf(have)
+	if hTyp == nil {
+		if isNillable(tTyp) {
+			return nil
+		}
+	} else if hTyp.ConvertibleTo(tTyp) {
+		return nil
+	}
+
+	ops := DefaultOptions(opts...)
+	msg := notice.New("expected type to be convertible").
+		Trail(ops.Trail).
+		Want("%s", tTyp).
+		Have("%T", have)
+	if tTyp.Kind() == reflect.Interface {
+		return appendMethodSet(msg, tTyp, hTyp)
+	}
+	return msg
+}
+
+// appendMethodSet appends to the message rows with interface "iTyp" methods
+// missing from the "hTyp" method set and methods with mismatched signatures.
+// Unexported methods are not visible to reflection, they are listed in a
+// separate row without checking the "hTyp" method set.
+func appendMethodSet(msg *notice.Notice, iTyp, hTyp reflect.Type) error {
+	var missing, mismatched, unexported []string
+	for i := range iTyp.NumMethod() {
+		iMet := iTyp.Method(i)
+		want := iMet.Name + funcSignature(iMet.Type)
+		if !iMet.IsExported() {
+			unexported = append(unexported, want+" (not checked)")
+			continue
+		}
+		if hTyp == nil {
+			missing = append(missing, want)
+			continue
+		}
+		hMet, ok := hTyp.MethodByName(iMet.Name)
+		if !ok {
+			if hTyp.Kind() != reflect.Ptr && hTyp.Kind() != reflect.Interface {
+				if _, ok = reflect.PointerTo(hTyp).MethodByName(iMet.Name); ok {
+					want += " (pointer receiver)"
+				}
+			}
+			missing = append(missing, want)
+			continue
+		}
+		if hTyp.Kind() != reflect.Interface {
+			hMet.Type = dropReceiver(hMet.Type)
+		}
+		if hMet.Type != iMet.Type {
+			have := iMet.Name + funcSignature(hMet.Type)
+			mismatched = append(mismatched, want+" != "+have)
+		}
+	}
+	if len(missing) > 0 {
+		_ = msg.Append("missing", "%s", strings.Join(missing, "\n"))
+	}
+	if len(mismatched) > 0 {
+		_ = msg.Append("mismatched", "%s", strings.Join(mismatched, "\n"))
+	}
+	if len(unexported) > 0 {
+		_ = msg.Append("unexported", "%s", strings.Join(unexported, "\n"))
+	}
+	return msg
+}
+
+// funcSignature returns function type signature without the "func" keyword.
+func funcSignature(typ reflect.Type) string {
+	return strings.TrimPrefix(typ.String(), "func")
+}
+
+// dropReceiver returns method type without the receiver argument.
+func dropReceiver(typ reflect.Type) reflect.Type {
+	in := make([]reflect.Type, 0, typ.NumIn()-1)
+	for i := 1; i < typ.NumIn(); i++ {
+		in = append(in, typ.In(i))
+	}
+	out := make([]reflect.Type, 0, typ.NumOut())
+	for i := range typ.NumOut() {
+		out = append(out, typ.Out(i))
+	}
+	return reflect.FuncOf(in, out, typ.IsVariadic())
+}
+
+// isNillable returns true if the nil value can be assigned to type.
+func isNillable(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map,
+		reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+		return true
+	default:
+		return false
+	}
+}
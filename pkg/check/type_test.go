@@ -0,0 +1,342 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+)
+
+func Test_Implements(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := Implements[types.TItf](&types.TPtr{})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - value receiver", func(t *testing.T) {
+		// --- When ---
+		err := Implements[error](types.TVal{})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - missing methods", func(t *testing.T) {
+		// --- When ---
+		err := Implements[io.ReadWriter](42)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to implement interface:\n" +
+			"     want: io.ReadWriter\n" +
+			"     have: int\n" +
+			"  missing:\n" +
+			"           Read([]uint8) (int, error)\n" +
+			"           Write([]uint8) (int, error)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - pointer receiver", func(t *testing.T) {
+		// --- When ---
+		err := Implements[types.TItf](types.TPtr{})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to implement interface:\n" +
+			"     want: types.TItf\n" +
+			"     have: types.TPtr\n" +
+			"  missing: AAA() string (pointer receiver)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - mismatched signature", func(t *testing.T) {
+		// --- When ---
+		err := Implements[types.TItf](types.TMis{})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to implement interface:\n" +
+			"        want: types.TItf\n" +
+			"        have: types.TMis\n" +
+			"  mismatched: AAA() string != AAA() int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - unexported methods", func(t *testing.T) {
+		// --- When ---
+		err := Implements[types.TItfUnx](types.TMis{})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to implement interface:\n" +
+			"        want: types.TItfUnx\n" +
+			"        have: types.TMis\n" +
+			"  mismatched: AAA() string != AAA() int\n" +
+			"  unexported: bbb() int (not checked)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - only unexported methods", func(t *testing.T) {
+		// --- When ---
+		err := Implements[types.TItfUnx](&types.TPtr{})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to implement interface:\n" +
+			"        want: types.TItfUnx\n" +
+			"        have: *types.TPtr\n" +
+			"  unexported: bbb() int (not checked)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - nil", func(t *testing.T) {
+		// --- When ---
+		err := Implements[types.TItf](nil)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to implement interface:\n" +
+			"     want: types.TItf\n" +
+			"     have: <nil>\n" +
+			"  missing: AAA() string"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not interface type parameter", func(t *testing.T) {
+		// --- When ---
+		err := Implements[int](42)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected interface type parameter:\n" +
+			"  got type: int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := Implements[types.TItf](types.TMis{}, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to implement interface:\n" +
+			"       trail: type.field\n" +
+			"        want: types.TItf\n" +
+			"        have: types.TMis\n" +
+			"  mismatched: AAA() string != AAA() int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_NotImplements(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		err := NotImplements[types.TItf](types.TPtr{})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - nil", func(t *testing.T) {
+		// --- When ---
+		err := NotImplements[types.TItf](nil)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := NotImplements[types.TItf](&types.TPtr{})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type not to implement interface:\n" +
+			"  want: types.TItf\n" +
+			"  have: *types.TPtr"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - not interface type parameter", func(t *testing.T) {
+		// --- When ---
+		err := NotImplements[types.TPtr](42)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected interface type parameter:\n" +
+			"  got type: types.TPtr"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := NotImplements[error](types.TVal{}, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type not to implement interface:\n" +
+			"  trail: type.field\n" +
+			"   want: error\n" +
+			"   have: types.TVal"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_AssignableTo(t *testing.T) {
+	t.Run("success - same type", func(t *testing.T) {
+		// --- When ---
+		err := AssignableTo[int](42)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - interface", func(t *testing.T) {
+		// --- When ---
+		err := AssignableTo[types.TItf](&types.TPtr{})
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - nil to nillable type", func(t *testing.T) {
+		// --- When ---
+		err := AssignableTo[[]int](nil)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error - named type", func(t *testing.T) {
+		// --- When ---
+		err := AssignableTo[int](types.TIntType(42))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to be assignable:\n" +
+			"  want: int\n" +
+			"  have: types.TIntType"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - nil", func(t *testing.T) {
+		// --- When ---
+		err := AssignableTo[int](nil)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to be assignable:\n" +
+			"  want: int\n" +
+			"  have: <nil>"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - interface", func(t *testing.T) {
+		// --- When ---
+		err := AssignableTo[types.TItf](types.TMis{})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to be assignable:\n" +
+			"        want: types.TItf\n" +
+			"        have: types.TMis\n" +
+			"  mismatched: AAA() string != AAA() int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := AssignableTo[string](42, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to be assignable:\n" +
+			"  trail: type.field\n" +
+			"   want: string\n" +
+			"   have: int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_ConvertibleTo(t *testing.T) {
+	t.Run("success - named type", func(t *testing.T) {
+		// --- When ---
+		err := ConvertibleTo[int](types.TIntType(42))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - numbers", func(t *testing.T) {
+		// --- When ---
+		err := ConvertibleTo[int](42.0)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("success - nil to nillable type", func(t *testing.T) {
+		// --- When ---
+		err := ConvertibleTo[error](nil)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- When ---
+		err := ConvertibleTo[string](4.2)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to be convertible:\n" +
+			"  want: string\n" +
+			"  have: float64"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("error - interface", func(t *testing.T) {
+		// --- When ---
+		err := ConvertibleTo[types.TItf](types.TPtr{})
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to be convertible:\n" +
+			"     want: types.TItf\n" +
+			"     have: types.TPtr\n" +
+			"  missing: AAA() string (pointer receiver)"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := ConvertibleTo[string](4.2, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected type to be convertible:\n" +
+			"  trail: type.field\n" +
+			"   want: string\n" +
+			"   have: float64"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
@@ -0,0 +1,288 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// XML checks that two XML strings are equivalent. Insignificant whitespace,
+// attribute order and namespace prefixes are ignored, elements are compared
+// using resolved namespaces. Use [WithIgnoreComments] option to ignore
+// comments. Returns nil if they are, otherwise it returns an error with a
+// message indicating the XPath-like trail of the first differing node or
+// attribute and the expected and actual values.
+//
+// Example:
+//
+//	check.XML(`<a x="1" y="2"/>`, `<a y="2" x="1"></a>`)
+func XML(want, have string, opts ...Option) error {
+	ops := DefaultOptions(opts...)
+	wDoc, err := parseXML(want, ops.IgnoreComments)
+	if err != nil {
+		return notice.New("did not expect unmarshalling error").
+			Trail(ops.Trail).
+			Append("argument", "want").
+			Append("error", "%s", err)
+	}
+	hDoc, err := parseXML(have, ops.IgnoreComments)
+	if err != nil {
+		return notice.New("did not expect unmarshalling error").
+			Trail(ops.Trail).
+			Append("argument", "have").
+			Append("error", "%s", err)
+	}
+	return xmlEqual(wDoc, hDoc, ops.Trail)
+}
+
+// Kinds of XML nodes.
+const (
+	xmlElement = "element"
+	xmlText    = "text"
+	xmlComment = "comment"
+)
+
+// xmlNode represents XML document node.
+type xmlNode struct {
+	kind  string     // Node kind.
+	name  xml.Name   // Element name with resolved namespace.
+	attrs []xml.Attr // Element attributes sorted by name.
+	text  string     // Text or comment content.
+	nodes []*xmlNode // Child nodes.
+}
+
+// label returns description of the node used in messages.
+func (n *xmlNode) label() string {
+	switch n.kind {
+	case xmlElement:
+		return "<" + xmlName(n.name) + ">"
+	case xmlText:
+		return strconv.Quote(n.text)
+	default:
+		return "<!--" + n.text + "-->"
+	}
+}
+
+// parseXML parses XML document to a tree of nodes. The returned node is a
+// document node with the root element as one of its children.
+func parseXML(doc string, ignoreComments bool) (*xmlNode, error) {
+	root := &xmlNode{}
+	stack := []*xmlNode{root}
+	dec := xml.NewDecoder(strings.NewReader(doc))
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		top := stack[len(stack)-1]
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			node := &xmlNode{kind: xmlElement, name: tok.Name}
+			for _, attr := range tok.Attr {
+				if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+					continue
+				}
+				node.attrs = append(node.attrs, attr)
+			}
+			slices.SortFunc(node.attrs, func(a, b xml.Attr) int {
+				return strings.Compare(xmlName(a.Name), xmlName(b.Name))
+			})
+			top.nodes = append(top.nodes, node)
+			stack = append(stack, node)
+
+		case xml.EndElement:
+			top.nodes = trimXMLText(top.nodes)
+			stack = stack[:len(stack)-1]
+
+		case xml.CharData:
+			last := len(top.nodes) - 1
+			if last >= 0 && top.nodes[last].kind == xmlText {
+				top.nodes[last].text += string(tok)
+				continue
+			}
+			top.nodes = append(top.nodes, &xmlNode{
+				kind: xmlText,
+				text: string(tok),
+			})
+
+		case xml.Comment:
+			if !ignoreComments {
+				top.nodes = append(top.nodes, &xmlNode{
+					kind: xmlComment,
+					text: string(tok),
+				})
+			}
+		}
+	}
+	root.nodes = trimXMLText(root.nodes)
+	if !slices.ContainsFunc(root.nodes, func(n *xmlNode) bool {
+		return n.kind == xmlElement
+	}) {
+		return nil, errors.New("missing root element")
+	}
+	return root, nil
+}
+
+// trimXMLText trims whitespace around text nodes and removes empty ones.
+func trimXMLText(nodes []*xmlNode) []*xmlNode {
+	return slices.DeleteFunc(nodes, func(n *xmlNode) bool {
+		if n.kind != xmlText {
+			return false
+		}
+		n.text = strings.TrimSpace(n.text)
+		return n.text == ""
+	})
+}
+
+// xmlEqual compares two XML nodes. Returns error describing the first
+// difference.
+//
+// nolint: cyclop
+func xmlEqual(want, have *xmlNode, trail string) error {
+	if want.kind != have.kind {
+		return notice.New("expected XML node kinds to be equal").
+			Trail(trail).
+			Want("%s", want.label()).
+			Have("%s", have.label())
+	}
+
+	switch want.kind {
+	case xmlText:
+		if want.text != have.text {
+			return notice.New("expected XML text to be equal").
+				Trail(trail).
+				Want("%s", want.text).
+				Have("%s", have.text)
+		}
+		return nil
+
+	case xmlComment:
+		if want.text != have.text {
+			return notice.New("expected XML comments to be equal").
+				Trail(trail).
+				Want("%s", want.text).
+				Have("%s", have.text)
+		}
+		return nil
+
+	case xmlElement:
+		if want.name != have.name {
+			return notice.New("expected XML element names to be equal").
+				Trail(trail).
+				Want("%s", xmlName(want.name)).
+				Have("%s", xmlName(have.name))
+		}
+		if err := xmlAttrsEqual(want.attrs, have.attrs, trail); err != nil {
+			return err
+		}
+	}
+
+	for i, wNode := range want.nodes {
+		wTrail := trail + "/" + xmlStep(want.nodes, i)
+		if i >= len(have.nodes) {
+			return notice.New("expected XML node").
+				Trail(wTrail).
+				Want("%s", wNode.label())
+		}
+		if err := xmlEqual(wNode, have.nodes[i], wTrail); err != nil {
+			return err
+		}
+	}
+	if len(have.nodes) > len(want.nodes) {
+		i := len(want.nodes)
+		return notice.New("unexpected XML node").
+			Trail(trail+"/"+xmlStep(have.nodes, i)).
+			Have("%s", have.nodes[i].label())
+	}
+	return nil
+}
+
+// xmlAttrsEqual compares sorted element attributes. Returns error describing
+// the first difference.
+func xmlAttrsEqual(want, have []xml.Attr, trail string) error {
+	for _, wAttr := range want {
+		aTrail := trail + "/@" + wAttr.Name.Local
+		idx := slices.IndexFunc(have, func(a xml.Attr) bool {
+			return a.Name == wAttr.Name
+		})
+		if idx == -1 {
+			return notice.New("expected XML attribute").
+				Trail(aTrail).
+				Want("%s", wAttr.Value)
+		}
+		if hVal := have[idx].Value; wAttr.Value != hVal {
+			return notice.New("expected XML attribute values to be equal").
+				Trail(aTrail).
+				Want("%s", wAttr.Value).
+				Have("%s", hVal)
+		}
+	}
+	for _, hAttr := range have {
+		if !slices.ContainsFunc(want, func(a xml.Attr) bool {
+			return a.Name == hAttr.Name
+		}) {
+			return notice.New("unexpected XML attribute").
+				Trail(trail+"/@"+hAttr.Name.Local).
+				Have("%s", hAttr.Value)
+		}
+	}
+	return nil
+}
+
+// xmlStep returns XPath-like location step for the node at index "idx". The
+// 1-based position is added only when there are more nodes of the same name
+// and kind.
+//
+// Example steps:
+//
+//	item
+//	item[2]
+//	text()
+//	comment()[1]
+func xmlStep(nodes []*xmlNode, idx int) string {
+	node := nodes[idx]
+	same := func(n *xmlNode) bool {
+		return n.kind == node.kind && n.name == node.name
+	}
+	var step string
+	switch node.kind {
+	case xmlElement:
+		step = node.name.Local
+	case xmlText:
+		step = "text()"
+	default:
+		step = "comment()"
+	}
+	total, pos := 0, 0
+	for i, n := range nodes {
+		if same(n) {
+			total++
+			if i <= idx {
+				pos++
+			}
+		}
+	}
+	if total > 1 {
+		step += "[" + strconv.Itoa(pos) + "]"
+	}
+	return step
+}
+
+// xmlName returns XML name with the namespace in curly braces.
+func xmlName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return "{" + name.Space + "}" + name.Local
+}
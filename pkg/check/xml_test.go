@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_XML(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := `<?xml version="1.0"?><a x="1" y="2"><b>text</b><c/></a>`
+		have := "<a y=\"2\" x=\"1\">\n\t<b>  text\n</b>\n\t<c></c>\n</a>\n"
+
+		// --- When ---
+		err := XML(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal - namespace prefixes", func(t *testing.T) {
+		// --- Given ---
+		want := `<p:a xmlns:p="urn:x" p:id="1"><p:b/></p:a>`
+		have := `<q:a xmlns:q="urn:x" q:id="1"><b xmlns="urn:x"/></q:a>`
+
+		// --- When ---
+		err := XML(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal - CDATA", func(t *testing.T) {
+		// --- When ---
+		err := XML(`<a><![CDATA[<b>]]></a>`, `<a>&lt;b&gt;</a>`)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal - ignore comments", func(t *testing.T) {
+		// --- Given ---
+		want := `<!-- A --><a><b/><!-- B --></a>`
+		have := `<a><!-- C --><b/></a>`
+
+		// --- When ---
+		err := XML(want, have, WithIgnoreComments)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal - comments", func(t *testing.T) {
+		// --- When ---
+		err := XML(`<a><!-- A --></a>`, `<a><!-- B --></a>`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected XML comments to be equal:\n" +
+			"  trail: /a/comment()\n" +
+			"   want:  A \n" +
+			"   have:  B "
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - text", func(t *testing.T) {
+		// --- Given ---
+		want := `<a><b>1</b><b>2</b></a>`
+		have := `<a><b>1</b><b>3</b></a>`
+
+		// --- When ---
+		err := XML(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected XML text to be equal:\n" +
+			"  trail: /a/b[2]/text()\n" +
+			"   want: 2\n" +
+			"   have: 3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - element name", func(t *testing.T) {
+		// --- Given ---
+		want := `<a xmlns="urn:x"><b/></a>`
+		have := `<a xmlns="urn:x"><c/></a>`
+
+		// --- When ---
+		err := XML(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected XML element names to be equal:\n" +
+			"  trail: /a/b\n" +
+			"   want: {urn:x}b\n" +
+			"   have: {urn:x}c"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - namespace", func(t *testing.T) {
+		// --- Given ---
+		want := `<p:a xmlns:p="urn:x"/>`
+		have := `<p:a xmlns:p="urn:y"/>`
+
+		// --- When ---
+		err := XML(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected XML element names to be equal:\n" +
+			"  trail: /a\n" +
+			"   want: {urn:x}a\n" +
+			"   have: {urn:y}a"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - node kind", func(t *testing.T) {
+		// --- When ---
+		err := XML(`<a><b/></a>`, `<a>b</a>`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected XML node kinds to be equal:\n" +
+			"  trail: /a/b\n" +
+			"   want: <b>\n" +
+			"   have: \"b\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - attribute value", func(t *testing.T) {
+		// --- Given ---
+		want := `<a><b id="1"/><b id="2"/></a>`
+		have := `<a><b id="1"/><b id="3"/></a>`
+
+		// --- When ---
+		err := XML(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected XML attribute values to be equal:\n" +
+			"  trail: /a/b[2]/@id\n" +
+			"   want: 2\n" +
+			"   have: 3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - missing attribute", func(t *testing.T) {
+		// --- When ---
+		err := XML(`<a id="1" x="2"/>`, `<a x="2"/>`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected XML attribute:\n" +
+			"  trail: /a/@id\n" +
+			"   want: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - unexpected attribute", func(t *testing.T) {
+		// --- When ---
+		err := XML(`<a x="2"/>`, `<a x="2" y="3"/>`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "unexpected XML attribute:\n" +
+			"  trail: /a/@y\n" +
+			"   have: 3"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - missing node", func(t *testing.T) {
+		// --- When ---
+		err := XML(`<a><b/><c/></a>`, `<a><b/></a>`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected XML node:\n" +
+			"  trail: /a/c\n" +
+			"   want: <c>"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not equal - unexpected node", func(t *testing.T) {
+		// --- When ---
+		err := XML(`<a><b/></a>`, `<a><b/><b/></a>`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "unexpected XML node:\n" +
+			"  trail: /a/b[2]\n" +
+			"   have: <b>"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("invalid want XML", func(t *testing.T) {
+		// --- When ---
+		err := XML(`<a>`, `<a/>`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect unmarshalling error:\n" +
+			"  argument: want\n" +
+			"     error: XML syntax error on line 1: unexpected EOF"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("invalid have XML", func(t *testing.T) {
+		// --- When ---
+		err := XML(`<a/>`, `  `)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "did not expect unmarshalling error:\n" +
+			"  argument: have\n" +
+			"     error: missing root element"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("log message with trail", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := XML(`<a><b>1</b></a>`, `<a><b>2</b></a>`, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "expected XML text to be equal:\n" +
+			"  trail: type.field/a/b/text()\n" +
+			"   want: 1\n" +
+			"   have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
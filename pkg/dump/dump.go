@@ -6,7 +6,11 @@ package dump
 
 import (
 	"reflect"
+	"regexp"
+	"slices"
 	"time"
+
+	"github.com/ctx42/testing/internal/core"
 )
 
 // Strings used by dump package to indicate special values.
@@ -17,6 +21,7 @@ const (
 	valChan       = "<chan>"    // The [reflect.Value] is a channel.
 	valInvalid    = "<invalid>" // The [reflect.Value] is invalid.
 	valMaxNesting = "<...>"     // The maximum nesting reached.
+	valMore       = "<more>"    // The iterator yields more items.
 
 	// The [reflect.Value] is unexpected in given context.
 	valErrUsage = "<dump-usage-error>"
@@ -106,6 +111,20 @@ func WithTabWidth(n int) Option {
 	return func(dmp *Dump) { dmp.TabWidth = n }
 }
 
+// WithScrubber is option for [New] adding [Scrubber] replacing parts of the
+// dumped values matching the regular expression with the replacement.
+func WithScrubber(re *regexp.Regexp, replacement string) Option {
+	return WithScrubbers(Scrubber{Pattern: re, Replacement: replacement})
+}
+
+// WithScrubbers is option for [New] adding scrubbers. Use it with built-in
+// scrubbers like [ScrubUUID] or [ScrubRFC3339].
+func WithScrubbers(scrs ...Scrubber) Option {
+	return func(dmp *Dump) {
+		dmp.Scrubbers = append(slices.Clip(dmp.Scrubbers), scrs...)
+	}
+}
+
 // Dump implements logic for dumping values and types.
 type Dump struct {
 	// Display values on one line.
@@ -160,6 +179,9 @@ type Dump struct {
 
 	// Default tab with in spaces.
 	TabWidth int
+
+	// Scrubbers applied, in order, to dumped values.
+	Scrubbers []Scrubber
 }
 
 // New returns new instance of [Dump].
@@ -192,13 +214,31 @@ func New(opts ...Option) Dump {
 }
 
 // Any dumps any value to its string representation.
+//
+// When the value is an [iter.Seq] or [iter.Seq2] iterator, the items it yields
+// are dumped. Iterators nested in other values are not called, they are
+// dumped as functions.
 func (dmp Dump) Any(val any) string {
-	return dmp.value(0, reflect.ValueOf(val))
+	return dmp.Value(reflect.ValueOf(val))
 }
 
-// Value dumps a [reflect.Value] representation of a value as a string.
+// Value dumps a [reflect.Value] representation of a value as a string. See
+// [Dump.Any] for details about dumping iterators.
 func (dmp Dump) Value(val reflect.Value) string {
-	return dmp.value(0, val)
+	if val.IsValid() && core.SeqArity(val.Type()) > 0 {
+		if _, ok := dmp.Dumpers[val.Type()]; !ok {
+			return dmp.Scrub(seqDumper(dmp, 0, val))
+		}
+	}
+	return dmp.Scrub(dmp.value(0, val))
+}
+
+// Scrub applies [Dump.Scrubbers] to the string.
+func (dmp Dump) Scrub(str string) string {
+	for _, scr := range dmp.Scrubbers {
+		str = scr.Scrub(str)
+	}
+	return str
 }
 
 // value dumps given a value as a string.
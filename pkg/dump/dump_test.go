@@ -6,6 +6,7 @@ package dump
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"testing"
 	"time"
 	"unsafe"
@@ -108,6 +109,51 @@ func Test_WithDumper(t *testing.T) {
 	affirm.Equal(t, `"3:04AM"`, have)
 }
 
+func Test_WithScrubber(t *testing.T) {
+	// --- Given ---
+	dmp := Dump{}
+	re := regexp.MustCompile(`id-\d+`)
+
+	// --- When ---
+	WithScrubber(re, "<id>")(&dmp)
+
+	// --- Then ---
+	affirm.Equal(t, 1, len(dmp.Scrubbers))
+	affirm.True(t, re == dmp.Scrubbers[0].Pattern)
+	affirm.Equal(t, "<id>", dmp.Scrubbers[0].Replacement)
+}
+
+func Test_WithScrubbers(t *testing.T) {
+	t.Run("add", func(t *testing.T) {
+		// --- Given ---
+		dmp := Dump{}
+
+		// --- When ---
+		WithScrubbers(ScrubUUID, ScrubAddr)(&dmp)
+
+		// --- Then ---
+		affirm.Equal(t, 2, len(dmp.Scrubbers))
+		affirm.Equal(t, "<uuid>", dmp.Scrubbers[0].Replacement)
+		affirm.Equal(t, "<addr>", dmp.Scrubbers[1].Replacement)
+	})
+
+	t.Run("copies do not share scrubbers", func(t *testing.T) {
+		// --- Given ---
+		dmp := Dump{Scrubbers: make([]Scrubber, 0, 10)}
+		WithScrubbers(ScrubUUID)(&dmp)
+		cpy0, cpy1 := dmp, dmp
+
+		// --- When ---
+		WithScrubbers(ScrubAddr)(&cpy0)
+		WithScrubbers(ScrubRFC3339)(&cpy1)
+
+		// --- Then ---
+		affirm.Equal(t, 1, len(dmp.Scrubbers))
+		affirm.Equal(t, "<addr>", cpy0.Scrubbers[1].Replacement)
+		affirm.Equal(t, "<time>", cpy1.Scrubbers[1].Replacement)
+	})
+}
+
 func Test_New(t *testing.T) {
 	t.Run("no options", func(t *testing.T) {
 		// --- When ---
@@ -124,6 +170,7 @@ func Test_New(t *testing.T) {
 		affirm.Equal(t, DefaultDepth, have.MaxDepth)
 		affirm.Equal(t, DefaultIndent, have.Indent)
 		affirm.Equal(t, DefaultTabWith, have.TabWidth)
+		affirm.True(t, have.Scrubbers == nil)
 
 		val, ok := have.Dumpers[typDur]
 		affirm.True(t, ok)
@@ -342,4 +389,63 @@ func Test_Dump_Any(t *testing.T) {
 		want := tstkit.Golden(t, "testdata/struct_nested_with_indent.txt")
 		affirm.Equal(t, want, have)
 	})
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		val := map[string]any{
+			"id":  "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a",
+			"at":  time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+			"key": "key-123",
+		}
+		re := regexp.MustCompile(`key-\d+`)
+		dmp := New(WithFlat, WithScrubbers(ScrubUUID, ScrubRFC3339))
+		WithScrubber(re, "key-<n>")(&dmp)
+
+		// --- When ---
+		have := dmp.Any(val)
+
+		// --- Then ---
+		want := `map[string]any{"at": "<time>", "id": "<uuid>", "key": "key-<n>"}`
+		affirm.Equal(t, want, have)
+	})
+}
+
+func Test_Dump_Value(t *testing.T) {
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(WithScrubbers(ScrubUUID))
+		val := reflect.ValueOf("id: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a")
+
+		// --- When ---
+		have := dmp.Value(val)
+
+		// --- Then ---
+		affirm.Equal(t, `"id: <uuid>"`, have)
+	})
+}
+
+func Test_Dump_Scrub(t *testing.T) {
+	t.Run("no scrubbers", func(t *testing.T) {
+		// --- Given ---
+		dmp := New()
+
+		// --- When ---
+		have := dmp.Scrub("7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a")
+
+		// --- Then ---
+		affirm.Equal(t, "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a", have)
+	})
+
+	t.Run("scrubbers applied in order", func(t *testing.T) {
+		// --- Given ---
+		dmp := New(
+			WithScrubber(regexp.MustCompile(`a`), "b"),
+			WithScrubber(regexp.MustCompile(`b`), "c"),
+		)
+
+		// --- When ---
+		have := dmp.Scrub("ab")
+
+		// --- Then ---
+		affirm.Equal(t, "cc", have)
+	})
 }
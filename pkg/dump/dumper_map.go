@@ -8,6 +8,8 @@ import (
 	"reflect"
 	"slices"
 	"strings"
+
+	"github.com/ctx42/testing/internal/core"
 )
 
 // mapDumper requires val to be dereferenced representation of [reflect.Map]
@@ -31,7 +33,7 @@ func mapDumper(dmp Dump, lvl int, val reflect.Value) string {
 	}
 
 	keys := val.MapKeys()
-	slices.SortStableFunc(keys, valueCmp)
+	slices.SortStableFunc(keys, core.ValueCmp)
 
 	if val.IsNil() {
 		return prn.Write("(nil)").String()
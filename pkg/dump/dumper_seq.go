@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/ctx42/testing/internal/core"
+)
+
+// seqDumper requires val to represent a function with [iter.Seq] or
+// [iter.Seq2] signature and returns string representation of the items it
+// yields in format defined by [Dump] configuration. At most [core.SeqLimit]
+// items are collected from the iterator.
+func seqDumper(dmp Dump, lvl int, val reflect.Value) string {
+	arity := core.SeqArity(val.Type())
+	if arity == 0 {
+		return valErrUsage
+	}
+
+	prn := NewPrinter(dmp)
+	prn.Tab(dmp.Indent + lvl)
+
+	if dmp.PrintType {
+		yield := val.Type().In(0)
+		types := make([]string, 0, arity)
+		for i := range arity {
+			typStr := strings.ReplaceAll(yield.In(i).String(), " ", "")
+			if typStr == "interface{}" && dmp.UseAny {
+				typStr = "any"
+			}
+			types = append(types, typStr)
+		}
+		name := "iter.Seq"
+		if arity == 2 {
+			name = "iter.Seq2"
+		}
+		prn.Write(name + "[" + strings.Join(types, ",") + "]")
+	}
+
+	if val.IsNil() {
+		return prn.Write("(nil)").String()
+	}
+
+	keys, vals, more := core.Collect(val, core.SeqLimit)
+	num := len(vals)
+	if more {
+		num++
+	}
+	prn.Write("{").NLI(num)
+
+	dmp.PrintType = false // Don't print types for yielded items.
+	for i, item := range vals {
+		last := i == num-1
+
+		if arity == 2 {
+			sub := dmp.value(lvl+1, keys[i])
+			prn.Write(sub)
+			prn.Write(":").Space()
+			sub = dmp.value(lvl+1, item)
+			prn.Write(strings.TrimLeft(sub, " \t"))
+		} else {
+			prn.Write(dmp.value(lvl+1, item))
+		}
+		prn.Comma(last).Sep(last).NL()
+	}
+	if more {
+		prn.Tab(dmp.Indent + lvl + 1).Write(valMore).NL()
+	}
+	prn.Tab(dmp.Indent + lvl).Write("}")
+
+	return prn.String()
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/core"
+)
+
+func Test_seqDumper_tabular(t *testing.T) {
+	var nilSeq iter.Seq[int]
+
+	tt := []struct {
+		testN string
+
+		dmp  Dump
+		val  any
+		want string
+	}{
+		{
+			"empty",
+			New(),
+			slices.Values([]int{}),
+			"iter.Seq[int]{}",
+		},
+		{
+			"nil",
+			New(),
+			nilSeq,
+			"iter.Seq[int](nil)",
+		},
+		{
+			"default iter.Seq",
+			New(),
+			slices.Values([]int{1, 2}),
+			"iter.Seq[int]{\n  1,\n  2,\n}",
+		},
+		{
+			"default iter.Seq with indent",
+			New(WithIndent(1)),
+			slices.Values([]int{1, 2}),
+			"  iter.Seq[int]{\n    1,\n    2,\n  }",
+		},
+		{
+			"flat iter.Seq",
+			New(WithFlat),
+			slices.Values([]string{"a", "b"}),
+			`iter.Seq[string]{"a", "b"}`,
+		},
+		{
+			"flat compact iter.Seq of any",
+			New(WithFlat, WithCompact),
+			slices.Values([]any{1, "a"}),
+			`iter.Seq[any]{1,"a"}`,
+		},
+		{
+			"func literal",
+			New(WithFlat),
+			func(yield func(int) bool) { yield(1) },
+			`iter.Seq[int]{1}`,
+		},
+		{
+			"default iter.Seq2",
+			New(),
+			slices.All([]string{"a", "b"}),
+			"iter.Seq2[int,string]{\n  0: \"a\",\n  1: \"b\",\n}",
+		},
+		{
+			"flat iter.Seq2",
+			New(WithFlat),
+			maps.All(map[string]int{"a": 1}),
+			`iter.Seq2[string,int]{"a": 1}`,
+		},
+		{
+			"nested",
+			New(WithFlat),
+			[]iter.Seq[int]{slices.Values([]int{1})},
+			`[]iter.Seq[int]{<func>(<addr>)}`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.dmp.Any(tc.val)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_seqDumper(t *testing.T) {
+	t.Run("more items than the limit", func(t *testing.T) {
+		// --- Given ---
+		seq := func(yield func(int) bool) {
+			for yield(1) {
+			}
+		}
+		dmp := New(WithFlat, WithCompact)
+
+		// --- When ---
+		have := seqDumper(dmp, 0, reflect.ValueOf(seq))
+
+		// --- Then ---
+		want := "iter.Seq[int]{" +
+			strings.Repeat("1,", core.SeqLimit) +
+			"<more>}"
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("more items than the limit not flat", func(t *testing.T) {
+		// --- Given ---
+		seq := func(yield func(int) bool) {
+			for yield(1) {
+			}
+		}
+
+		// --- When ---
+		have := seqDumper(New(), 0, reflect.ValueOf(seq))
+
+		// --- Then ---
+		affirm.True(t, strings.HasSuffix(have, "  1,\n  <more>\n}"))
+	})
+
+	t.Run("usage error", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf(func() {})
+
+		// --- When ---
+		have := seqDumper(New(), 0, val)
+
+		// --- Then ---
+		affirm.Equal(t, valErrUsage, have)
+	})
+}
+
+func Test_Dump_Any_seq(t *testing.T) {
+	t.Run("nested iterator is not called", func(t *testing.T) {
+		// --- Given ---
+		var calls int
+		seq := func(yield func(int) bool) { calls++ }
+		val := struct{ Seq func(func(int) bool) }{Seq: seq}
+
+		// --- When ---
+		have := New(WithFlat, WithCompact).Any(val)
+
+		// --- Then ---
+		affirm.Equal(t, "{Seq:<func>(<addr>)}", have)
+		affirm.Equal(t, 0, calls)
+	})
+
+	t.Run("custom dumper", func(t *testing.T) {
+		// --- Given ---
+		seq := slices.Values([]int{1})
+		fn := func(dmp Dump, lvl int, val reflect.Value) string {
+			return "custom"
+		}
+		dmp := New(WithDumper(seq, fn))
+
+		// --- When ---
+		have := dmp.Any(seq)
+
+		// --- Then ---
+		affirm.Equal(t, "custom", have)
+	})
+}
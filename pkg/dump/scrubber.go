@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// Built-in scrubbers for volatile values.
+var (
+	// ScrubUUID replaces UUIDs with "<uuid>".
+	ScrubUUID = Scrubber{
+		Pattern: regexp.MustCompile(
+			`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`,
+		),
+		Replacement: "<uuid>",
+	}
+
+	// ScrubRFC3339 replaces [time.RFC3339] and [time.RFC3339Nano] times with
+	// "<time>".
+	ScrubRFC3339 = Scrubber{
+		Pattern: regexp.MustCompile(
+			`\b\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})`,
+		),
+		Replacement: "<time>",
+	}
+
+	// ScrubTempDir replaces paths of directories created by [testing.T.TempDir]
+	// with "<tempdir>". Paths to files and directories in them keep the part
+	// relative to the temporary directory.
+	ScrubTempDir = Scrubber{
+		Pattern: regexp.MustCompile(
+			regexp.QuoteMeta(filepath.Clean(os.TempDir())) +
+				`[/\\][^/\\\s"'` + "`" + `]+[/\\]\d{3,}`,
+		),
+		Replacement: "<tempdir>",
+	}
+
+	// ScrubAddr replaces hexadecimal addresses with at least six digits with
+	// "<addr>".
+	ScrubAddr = Scrubber{
+		Pattern:     regexp.MustCompile(`\b0x[0-9a-fA-F]{6,}\b`),
+		Replacement: "<addr>",
+	}
+)
+
+// Scrubber replaces volatile parts of strings, like identifiers, times or
+// addresses, with stable placeholders.
+type Scrubber struct {
+	// Regular expression matching volatile values.
+	Pattern *regexp.Regexp
+
+	// Replacement for matched values. Inside the replacement, $ signs are
+	// interpreted as in [regexp.Regexp.Expand].
+	Replacement string
+}
+
+// Scrub returns "str" with all values matching the pattern replaced.
+func (scr Scrubber) Scrub(str string) string {
+	return scr.Pattern.ReplaceAllString(str, scr.Replacement)
+}
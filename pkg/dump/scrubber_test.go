@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package dump
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_ScrubUUID(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		have string
+		want string
+	}{
+		{"lower case", "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a", "<uuid>"},
+		{"upper case", "7F0C5B6E-8C4A-4C7E-9D3A-2B1F0E6D5C4A", "<uuid>"},
+		{
+			"in text",
+			`id: "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a",`,
+			`id: "<uuid>",`,
+		},
+		{
+			"multiple",
+			"00000000-0000-0000-0000-000000000000 " +
+				"ffffffff-ffff-ffff-ffff-ffffffffffff",
+			"<uuid> <uuid>",
+		},
+		{
+			"not uuid",
+			"7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4",
+			"7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4",
+		},
+		{
+			"part of longer word",
+			"a7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a",
+			"a7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := ScrubUUID.Scrub(tc.have)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_ScrubRFC3339(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		have string
+		want string
+	}{
+		{"utc", "2020-01-02T03:04:05Z", "<time>"},
+		{"offset", "2020-01-02T03:04:05+01:00", "<time>"},
+		{"nano", "2020-01-02T03:04:05.123456789-07:00", "<time>"},
+		{"in text", `at: "2020-01-02T03:04:05Z",`, `at: "<time>",`},
+		{"date only", "2020-01-02", "2020-01-02"},
+		{"no zone", "2020-01-02T03:04:05", "2020-01-02T03:04:05"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := ScrubRFC3339.Scrub(tc.have)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_ScrubTempDir(t *testing.T) {
+	t.Run("temporary directory", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+
+		// --- When ---
+		have := ScrubTempDir.Scrub(dir)
+
+		// --- Then ---
+		affirm.Equal(t, "<tempdir>", have)
+	})
+
+	t.Run("path in temporary directory", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "sub", "file.txt")
+
+		// --- When ---
+		have := ScrubTempDir.Scrub("path: " + pth)
+
+		// --- Then ---
+		want := "path: " + filepath.Join("<tempdir>", "sub", "file.txt")
+		affirm.Equal(t, want, have)
+	})
+
+	t.Run("not temporary directory", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(os.TempDir(), "file.txt")
+
+		// --- When ---
+		have := ScrubTempDir.Scrub(pth)
+
+		// --- Then ---
+		affirm.Equal(t, pth, have)
+	})
+}
+
+func Test_ScrubAddr(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		have string
+		want string
+	}{
+		{"address", "0xc000012345", "<addr>"},
+		{"upper case", "0xC000012345", "<addr>"},
+		{"in text", "<func> // 0xc000012345", "<func> // <addr>"},
+		{"short hex", "0x01", "0x01"},
+		{"not hex", "0xc00001234g", "0xc00001234g"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := ScrubAddr.Scrub(tc.have)
+
+			// --- Then ---
+			affirm.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Scrubber_Scrub(t *testing.T) {
+	t.Run("replacement with submatch", func(t *testing.T) {
+		// --- Given ---
+		scr := Scrubber{
+			Pattern:     regexp.MustCompile(`(\w+)=\d+`),
+			Replacement: "$1=<n>",
+		}
+
+		// --- When ---
+		have := scr.Scrub("a=1 b=22")
+
+		// --- Then ---
+		affirm.Equal(t, "a=<n> b=<n>", have)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		// --- Given ---
+		scr := Scrubber{Pattern: regexp.MustCompile(`\d+`), Replacement: "<n>"}
+
+		// --- When ---
+		have := scr.Scrub("abc")
+
+		// --- Then ---
+		affirm.Equal(t, "abc", have)
+	})
+}
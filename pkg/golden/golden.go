@@ -0,0 +1,377 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+// Package golden provides helpers comparing values with golden files.
+//
+// A golden file starts with optional documentation followed by one or more
+// sections. Each section starts with a marker line. The default section starts
+// with "---" line and named sections start with "--- name" lines. The section
+// content ends at the next marker line or at the end of the file. The new line
+// preceding the next marker is not part of the section content. Content lines
+// which would be read as markers are written prefixed with a backslash, and
+// one leading backslash is removed from such lines when the file is read.
+//
+//	Documentation describing the golden file contents.
+//	---
+//	Content of the default section.
+//	--- error
+//	Content of the "error" section.
+//
+// Golden files are rewritten with the actual values when tests are run with
+// [EnvUpdate] environment variable set to true or with the "-update" flag.
+// The package doesn't define the flag, so it never clashes with flags defined
+// by tests. To use it, define the flag in the test package:
+//
+//	var _ = flag.Bool(golden.FlagUpdate, false, "update golden files")
+package golden
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// EnvUpdate is the name of environment variable which, when set to true,
+// makes helpers update golden files instead of comparing with them.
+const EnvUpdate = "GOLDEN_UPDATE"
+
+// FlagUpdate is the name of the command line flag which makes helpers update
+// golden files instead of comparing with them. The flag is not defined by
+// this package, it is used only when defined by the test package.
+const FlagUpdate = "update"
+
+// Section markers.
+const (
+	marker       = "---"  // Default section marker.
+	markerPrefix = "--- " // Named section marker prefix.
+	markerEscape = `\`    // Prefix of content lines which look like markers.
+)
+
+// Updating returns true when golden files should be updated with the actual
+// values. It is true when the "-update" flag is defined and set or [EnvUpdate]
+// environment variable is set to true.
+func Updating() bool {
+	if f := flag.Lookup(FlagUpdate); f != nil {
+		if v, _ := strconv.ParseBool(f.Value.String()); v {
+			return true
+		}
+	}
+	v, _ := strconv.ParseBool(os.Getenv(EnvUpdate))
+	return v
+}
+
+// Option represents [Equal] and [Read] option.
+type Option func(*Options)
+
+// WithSection is option setting the name of the golden file section to use.
+func WithSection(name string) Option {
+	return func(ops *Options) { ops.Section = name }
+}
+
+// WithTemplate is option making the section content to be expanded as
+// [text/template] with the given data before it's used.
+func WithTemplate(data any) Option {
+	return func(ops *Options) {
+		ops.Template = true
+		ops.Data = data
+	}
+}
+
+// WithUpdate is option making [Equal] update the golden file regardless of
+// the "-update" flag and [EnvUpdate] environment variable.
+func WithUpdate(ops *Options) { ops.Update = true }
+
+// WithCheck is option setting [check.Option] options used when comparing
+// golden file content.
+func WithCheck(opts ...check.Option) Option {
+	return func(ops *Options) { ops.CheckOpts = append(ops.CheckOpts, opts...) }
+}
+
+// Options represents [Equal] and [Read] options.
+type Options struct {
+	// Name of the section, empty string for the default section.
+	Section string
+
+	// Expand section content as [text/template] with Data.
+	Template bool
+
+	// Data for the template.
+	Data any
+
+	// Update golden file with the actual value.
+	Update bool
+
+	// Options used when comparing golden file content.
+	CheckOpts []check.Option
+}
+
+// DefaultOptions returns default [Options].
+func DefaultOptions(opts ...Option) Options {
+	ops := Options{Update: Updating()}
+	for _, opt := range opts {
+		opt(&ops)
+	}
+	return ops
+}
+
+// Equal asserts golden file section at "pth" is equal to "have". When in
+// update mode, the section is set to "have" and the golden file is written
+// (created when it doesn't exist). Scrubbers set with [check.WithScrubber]
+// option passed with [WithCheck] are applied to both the section and "have"
+// before they are compared or written. Returns true on success, otherwise
+// marks the test as failed, writes error message to test log and returns
+// false.
+//
+//	golden.Equal(t, "testdata/response.golden", body)
+func Equal[T check.Content](
+	t tester.T,
+	pth string,
+	have T,
+	opts ...Option,
+) bool {
+
+	t.Helper()
+	ops := DefaultOptions(opts...)
+	if ops.Update {
+		cOps := check.DefaultOptions(ops.CheckOpts...)
+		if e := write(pth, cOps.Dumper.Scrub(string(have)), ops); e != nil {
+			t.Error(e)
+			return false
+		}
+		return true
+	}
+	want, e := read(pth, ops)
+	if e != nil {
+		t.Error(e)
+		return false
+	}
+	e = check.LinesEqual(want, string(have), ops.CheckOpts...)
+	if e == nil {
+		return true
+	}
+	var msg *notice.Notice
+	if errors.As(e, &msg) {
+		_ = msg.SetHeader("expected golden file content to be equal")
+		if ops.Section != "" {
+			_ = msg.Prepend("section", "%s", ops.Section)
+		}
+		_ = msg.Prepend("path", "%s", pth)
+	}
+	t.Error(e)
+	return false
+}
+
+// Read returns the golden file section at "pth". It marks the test as failed
+// and stops its execution when the section cannot be read.
+func Read(t tester.T, pth string, opts ...Option) string {
+	t.Helper()
+	content, e := read(pth, DefaultOptions(opts...))
+	if e != nil {
+		t.Fatal(e)
+	}
+	return content
+}
+
+// read reads the golden file section at "pth".
+func read(pth string, ops Options) (string, error) {
+	data, err := os.ReadFile(pth)
+	if err != nil {
+		return "", newNotice("expected no error reading golden file", pth, ops).
+			Append("error", "%s", err)
+	}
+	doc, err := parse(string(data))
+	if err != nil {
+		return "", newNotice("expected valid golden file", pth, ops).
+			Append("error", "%s", err)
+	}
+	idx := doc.index(ops.Section)
+	if idx == -1 {
+		return "", newNotice("expected golden file section", pth, ops)
+	}
+	content := doc.sections[idx].content
+	if !ops.Template {
+		return content, nil
+	}
+	tpl, err := template.New(filepath.Base(pth)).Parse(content)
+	if err == nil {
+		buf := &bytes.Buffer{}
+		if err = tpl.Execute(buf, ops.Data); err == nil {
+			return buf.String(), nil
+		}
+	}
+	msg := newNotice("expected no error executing golden file template", pth, ops)
+	return "", msg.Append("error", "%s", err)
+}
+
+// write sets the golden file section at "pth" to "content" and writes the
+// file. The file and its parent directories are created when they don't exist.
+func write(pth, content string, ops Options) error {
+	if ops.Template {
+		header := "expected golden file without template to update"
+		return newNotice(header, pth, ops)
+	}
+	perm := fs.FileMode(0o644)
+	doc := document{}
+	data, err := os.ReadFile(pth)
+	if err == nil {
+		if doc, err = parse(string(data)); err != nil {
+			return newNotice("expected valid golden file", pth, ops).
+				Append("error", "%s", err)
+		}
+		if inf, err := os.Stat(pth); err == nil {
+			perm = inf.Mode().Perm()
+		}
+	}
+	doc.set(ops.Section, content)
+	err = os.MkdirAll(filepath.Dir(pth), 0o755)
+	if err == nil {
+		err = os.WriteFile(pth, []byte(doc.String()), perm)
+	}
+	if err != nil {
+		return newNotice("expected no error writing golden file", pth, ops).
+			Append("error", "%s", err)
+	}
+	return nil
+}
+
+// newNotice returns a new notice with the golden file path and section.
+func newNotice(header, pth string, ops Options) *notice.Notice {
+	msg := notice.New(header).
+		Trail(check.DefaultOptions(ops.CheckOpts...).Trail).
+		Append("path", "%s", pth)
+	if ops.Section != "" {
+		_ = msg.Append("section", "%s", ops.Section)
+	}
+	return msg
+}
+
+// section represents golden file section.
+type section struct {
+	name    string // Section name, empty for the default section.
+	content string // Section content.
+}
+
+// document represents golden file.
+type document struct {
+	head     string    // Content before the first marker.
+	sections []section // Sections in order of appearance.
+}
+
+// parse parses golden file contents.
+func parse(data string) (document, error) {
+	var doc document
+	var cur *section
+	for _, line := range strings.SplitAfter(data, "\n") {
+		name, ok := isMarker(line)
+		if !ok {
+			if cur == nil {
+				doc.head += line
+			} else {
+				if isEscaped(line) {
+					line = line[len(markerEscape):]
+				}
+				cur.content += line
+			}
+			continue
+		}
+		if cur != nil {
+			cur.content = strings.TrimSuffix(cur.content, "\n")
+			cur.content = strings.TrimSuffix(cur.content, "\r")
+		}
+		if doc.index(name) != -1 {
+			return document{}, errors.New("duplicate section: " + name)
+		}
+		doc.sections = append(doc.sections, section{name: name})
+		cur = &doc.sections[len(doc.sections)-1]
+	}
+	if len(doc.sections) == 0 {
+		return document{}, errors.New("missing \"---\" marker")
+	}
+	return doc, nil
+}
+
+// isMarker returns section name and true if the line is a section marker.
+func isMarker(line string) (string, bool) {
+	line = strings.TrimRight(line, "\r\n")
+	if line == marker {
+		return "", true
+	}
+	if name, ok := strings.CutPrefix(line, markerPrefix); ok {
+		if name = strings.TrimSpace(name); name != "" {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// isEscaped returns true if the line is a content line looking like a marker
+// prefixed with one or more [markerEscape] strings.
+func isEscaped(line string) bool {
+	trimmed := strings.TrimLeft(line, markerEscape)
+	if len(trimmed) == len(line) {
+		return false
+	}
+	_, ok := isMarker(trimmed)
+	return ok
+}
+
+// escape returns section content with lines which would be read as markers
+// or as escaped content lines prefixed with [markerEscape].
+func escape(content string) string {
+	lines := strings.SplitAfter(content, "\n")
+	for i, line := range lines {
+		if _, ok := isMarker(line); ok || isEscaped(line) {
+			lines[i] = markerEscape + line
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+// index returns index of the section with given name or -1 if it doesn't
+// exist.
+func (doc document) index(name string) int {
+	for i, sec := range doc.sections {
+		if sec.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// set sets content of the section with given name. The section is added at
+// the end of the document when it doesn't exist.
+func (doc *document) set(name, content string) {
+	if idx := doc.index(name); idx != -1 {
+		doc.sections[idx].content = content
+		return
+	}
+	doc.sections = append(doc.sections, section{name: name, content: content})
+}
+
+// String returns golden file contents.
+func (doc document) String() string {
+	buf := &strings.Builder{}
+	buf.WriteString(doc.head)
+	for i, sec := range doc.sections {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		if sec.name == "" {
+			buf.WriteString(marker + "\n")
+		} else {
+			buf.WriteString(markerPrefix + sec.name + "\n")
+		}
+		buf.WriteString(escape(sec.content))
+	}
+	return buf.String()
+}
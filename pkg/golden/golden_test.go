@@ -0,0 +1,591 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package golden
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/dump"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// The update flag is defined the way test packages using golden files do.
+var _ = flag.Bool(FlagUpdate, false, "update golden files")
+
+func Test_Updating(t *testing.T) {
+	t.Run("not updating", func(t *testing.T) {
+		// --- Given ---
+		noUpdate(t)
+
+		// --- When ---
+		have := Updating()
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("environment variable", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(EnvUpdate, "true")
+
+		// --- When ---
+		have := Updating()
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("flag", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(EnvUpdate, "")
+		affirm.Nil(t, flagSet(t, "true"))
+
+		// --- When ---
+		have := Updating()
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+}
+
+func Test_WithSection(t *testing.T) {
+	// --- Given ---
+	ops := &Options{}
+
+	// --- When ---
+	WithSection("name")(ops)
+
+	// --- Then ---
+	affirm.Equal(t, "name", ops.Section)
+}
+
+func Test_WithTemplate(t *testing.T) {
+	// --- Given ---
+	ops := &Options{}
+
+	// --- When ---
+	WithTemplate(123)(ops)
+
+	// --- Then ---
+	affirm.True(t, ops.Template)
+	affirm.Equal(t, 123, ops.Data)
+}
+
+func Test_WithUpdate(t *testing.T) {
+	// --- Given ---
+	ops := &Options{}
+
+	// --- When ---
+	WithUpdate(ops)
+
+	// --- Then ---
+	affirm.True(t, ops.Update)
+}
+
+func Test_WithCheck(t *testing.T) {
+	// --- Given ---
+	ops := &Options{}
+
+	// --- When ---
+	WithCheck(check.WithTrail("a"), check.WithTrail("b"))(ops)
+
+	// --- Then ---
+	affirm.Equal(t, 2, len(ops.CheckOpts))
+}
+
+func Test_DefaultOptions(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		// --- Given ---
+		noUpdate(t)
+
+		// --- When ---
+		have := DefaultOptions()
+
+		// --- Then ---
+		affirm.Equal(t, "", have.Section)
+		affirm.False(t, have.Template)
+		affirm.Nil(t, have.Data)
+		affirm.False(t, have.Update)
+		affirm.True(t, have.CheckOpts == nil)
+	})
+
+	t.Run("update from environment", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(EnvUpdate, "1")
+
+		// --- When ---
+		have := DefaultOptions(WithSection("name"))
+
+		// --- Then ---
+		affirm.Equal(t, "name", have.Section)
+		affirm.True(t, have.Update)
+	})
+}
+
+func Test_Equal(t *testing.T) {
+	// Tests use files from testdata which must not be updated.
+	noUpdate(t)
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Equal(tspy, "testdata/default.txt", "line 1\nline 2\n")
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("equal byte slice", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Equal(tspy, "testdata/default.txt", []byte("line 1\nline 2\n"))
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("equal section", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		opt := WithSection("request")
+
+		// --- When ---
+		have := Equal(tspy, "testdata/sections.txt", "GET /users/{{ .ID }}", opt)
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("equal template", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		opts := []Option{
+			WithSection("response"),
+			WithTemplate(map[string]int{"ID": 42}),
+		}
+
+		// --- When ---
+		have := Equal(tspy, "testdata/sections.txt", "{\"id\": 42}\n", opts...)
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual(
+			"expected golden file content to be equal:\n" +
+				"    path: testdata/default.txt\n" +
+				"    line: 2\n" +
+				"    want: \"line 2\"\n" +
+				"    have: \"line 3\"\n" +
+				"  offset: 5\n" +
+				"    diff:\n" +
+				"          \"line 2\"\n" +
+				"          \"line 3\"\n" +
+				"                ^",
+		)
+		tspy.Close()
+
+		// --- When ---
+		have := Equal(tspy, "testdata/default.txt", "line 1\nline 3\n")
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("not equal section with trail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain(
+			"expected golden file content to be equal:\n" +
+				"    trail: type.field\n" +
+				"     path: testdata/sections.txt\n" +
+				"  section: request\n" +
+				"     line: 1\n",
+		)
+		tspy.Close()
+
+		opts := []Option{
+			WithSection("request"),
+			WithCheck(check.WithTrail("type.field")),
+		}
+
+		// --- When ---
+		have := Equal(tspy, "testdata/sections.txt", "GET /users", opts...)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("error missing section", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual(
+			"expected golden file section:\n" +
+				"     path: testdata/default.txt\n" +
+				"  section: missing",
+		)
+		tspy.Close()
+
+		opt := WithSection("missing")
+
+		// --- When ---
+		have := Equal(tspy, "testdata/default.txt", "", opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("error missing marker", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual(
+			"expected valid golden file:\n" +
+				"   path: testdata/no_marker.txt\n" +
+				"  error: missing \"---\" marker",
+		)
+		tspy.Close()
+
+		// --- When ---
+		have := Equal(tspy, "testdata/no_marker.txt", "")
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("error not existing file", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual(
+			"expected no error reading golden file:\n" +
+				"   path: testdata/not_existing.txt\n" +
+				"  error: open testdata/not_existing.txt: " +
+				"no such file or directory",
+		)
+		tspy.Close()
+
+		// --- When ---
+		have := Equal(tspy, "testdata/not_existing.txt", "")
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("error template", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain(
+			"expected no error executing golden file template:\n" +
+				"   path: testdata/bad_template.txt\n" +
+				"  error: template: bad_template.txt:",
+		)
+		tspy.Close()
+
+		opt := WithTemplate(nil)
+
+		// --- When ---
+		have := Equal(tspy, "testdata/bad_template.txt", "", opt)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("update creates file", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "sub", "golden.txt")
+
+		// --- When ---
+		have := Equal(tspy, pth, "content\n", WithUpdate)
+
+		// --- Then ---
+		affirm.True(t, have)
+		affirm.Equal(t, "---\ncontent\n", readFile(t, pth))
+	})
+
+	t.Run("update keeps documentation and other sections", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "golden.txt")
+		content := "Doc.\n---\ndefault\n--- a\nA\n--- b\nB\n"
+		affirm.Nil(t, os.WriteFile(pth, []byte(content), 0o600))
+
+		// --- When ---
+		have := Equal(tspy, pth, "new A", WithUpdate, WithSection("a"))
+
+		// --- Then ---
+		affirm.True(t, have)
+		want := "Doc.\n---\ndefault\n--- a\nnew A\n--- b\nB\n"
+		affirm.Equal(t, want, readFile(t, pth))
+		affirm.True(t, Equal(tspy, pth, "new A", WithSection("a")))
+		affirm.True(t, Equal(tspy, pth, "B\n", WithSection("b")))
+	})
+
+	t.Run("update adds section", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "golden.txt")
+		affirm.Nil(t, os.WriteFile(pth, []byte("---\ndefault\n"), 0o600))
+
+		// --- When ---
+		have := Equal(tspy, pth, "C\n", WithUpdate, WithSection("c"))
+
+		// --- Then ---
+		affirm.True(t, have)
+		affirm.Equal(t, "---\ndefault\n\n--- c\nC\n", readFile(t, pth))
+		affirm.True(t, Equal(tspy, pth, "default\n"))
+	})
+
+	t.Run("update round trip with marker like lines", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "golden.txt")
+		content := "diff:\n--- a/file.txt\n+++ b/file.txt\n"
+
+		// --- When ---
+		have := Equal(tspy, pth, content, WithUpdate)
+
+		// --- Then ---
+		affirm.True(t, have)
+		want := "---\ndiff:\n\\--- a/file.txt\n+++ b/file.txt\n"
+		affirm.Equal(t, want, readFile(t, pth))
+		affirm.True(t, Equal(tspy, pth, content))
+	})
+
+	t.Run("update round trip with default marker line", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "golden.txt")
+		content := "a\n---\nb"
+
+		// --- When ---
+		have := Equal(tspy, pth, content, WithUpdate)
+
+		// --- Then ---
+		affirm.True(t, have)
+		affirm.Equal(t, "---\na\n\\---\nb", readFile(t, pth))
+		affirm.True(t, Equal(tspy, pth, content))
+		affirm.True(t, Equal(tspy, pth, content, WithUpdate))
+		affirm.True(t, Equal(tspy, pth, content))
+	})
+
+	t.Run("update round trip with escaped marker line", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "golden.txt")
+		content := "\\--- a\n\\x"
+
+		// --- When ---
+		have := Equal(tspy, pth, content, WithUpdate)
+
+		// --- Then ---
+		affirm.True(t, have)
+		affirm.Equal(t, "---\n\\\\--- a\n\\x", readFile(t, pth))
+		affirm.True(t, Equal(tspy, pth, content))
+	})
+
+	t.Run("update from environment", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(EnvUpdate, "true")
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "golden.txt")
+
+		// --- When ---
+		have := Equal(tspy, pth, "content")
+
+		// --- Then ---
+		affirm.True(t, have)
+		affirm.Equal(t, "---\ncontent", readFile(t, pth))
+	})
+
+	t.Run("error update with template", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual(
+			"expected golden file without template to update:\n" +
+				"  path: testdata/sections.txt",
+		)
+		tspy.Close()
+
+		opts := []Option{WithUpdate, WithTemplate(nil)}
+
+		// --- When ---
+		have := Equal(tspy, "testdata/sections.txt", "", opts...)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("equal with scrubbers", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "golden.txt")
+		affirm.Nil(t, os.WriteFile(pth, []byte("---\nid: <uuid>\n"), 0o600))
+		opt := WithCheck(check.WithScrubbers(dump.ScrubUUID))
+		content := "id: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a\n"
+
+		// --- When ---
+		have := Equal(tspy, pth, content, opt)
+
+		// --- Then ---
+		affirm.True(t, have)
+	})
+
+	t.Run("update with scrubbers", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		pth := filepath.Join(t.TempDir(), "golden.txt")
+		opts := []Option{
+			WithUpdate,
+			WithCheck(check.WithScrubbers(dump.ScrubUUID)),
+		}
+		content := "id: 7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a\n"
+
+		// --- When ---
+		have := Equal(tspy, pth, content, opts...)
+
+		// --- Then ---
+		affirm.True(t, have)
+		affirm.Equal(t, "---\nid: <uuid>\n", readFile(t, pth))
+	})
+}
+
+func Test_Read(t *testing.T) {
+	noUpdate(t)
+
+	t.Run("default section", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		// --- When ---
+		have := Read(tspy, "testdata/sections.txt")
+
+		// --- Then ---
+		affirm.Equal(t, "default", have)
+	})
+
+	t.Run("template section", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+		opts := []Option{
+			WithSection("request"),
+			WithTemplate(struct{ ID int }{7}),
+		}
+
+		// --- When ---
+		have := Read(tspy, "testdata/sections.txt", opts...)
+
+		// --- Then ---
+		affirm.Equal(t, "GET /users/7", have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectFail()
+		tspy.ExpectLogContain("expected no error reading golden file:\n")
+		tspy.Close()
+
+		var have string
+
+		// --- When ---
+		fn := func() { have = Read(tspy, "testdata/not_existing.txt") }
+
+		// --- Then ---
+		msg := affirm.Panic(t, fn)
+		affirm.NotNil(t, *msg)
+		affirm.Equal(t, "", have)
+	})
+}
+
+func Test_parse(t *testing.T) {
+	t.Run("content to the end of file", func(t *testing.T) {
+		// --- When ---
+		doc, err := parse("doc\n---\nline 1\n---x\n")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, "doc\n", doc.head)
+		affirm.Equal(t, 1, len(doc.sections))
+		affirm.Equal(t, "line 1\n---x\n", doc.sections[0].content)
+	})
+
+	t.Run("windows new lines", func(t *testing.T) {
+		// --- When ---
+		doc, err := parse("---\r\nA\r\n--- b\r\nB")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, 2, len(doc.sections))
+		affirm.Equal(t, "A", doc.sections[0].content)
+		affirm.Equal(t, "b", doc.sections[1].name)
+		affirm.Equal(t, "B", doc.sections[1].content)
+	})
+
+	t.Run("escaped marker lines", func(t *testing.T) {
+		// --- When ---
+		doc, err := parse("---\n\\---\n\\\\--- a\n\\x\n--- b\nB")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, 2, len(doc.sections))
+		affirm.Equal(t, "---\n\\--- a\n\\x", doc.sections[0].content)
+		affirm.Equal(t, "B", doc.sections[1].content)
+	})
+
+	t.Run("error duplicate section", func(t *testing.T) {
+		// --- When ---
+		_, err := parse("--- a\nA\n--- a\nB")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, "duplicate section: a", err.Error())
+	})
+}
+
+// flagSet sets the update flag value for the duration of the test.
+func flagSet(t *testing.T, value string) error {
+	t.Helper()
+	f := flag.Lookup(FlagUpdate)
+	prev := f.Value.String()
+	t.Cleanup(func() { _ = f.Value.Set(prev) })
+	return f.Value.Set(value)
+}
+
+// noUpdate turns off the update mode for the duration of the test.
+func noUpdate(t *testing.T) {
+	t.Helper()
+	t.Setenv(EnvUpdate, "")
+	if err := flagSet(t, "false"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readFile returns contents of the file at "pth".
+func readFile(t *testing.T, pth string) string {
+	t.Helper()
+	data, err := os.ReadFile(pth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,463 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+// Package snapshot provides snapshot testing of values rendered with
+// [dump.Dump].
+//
+// Snapshots of a top level test and its subtests are kept in a single file
+// "testdata/__snapshots__/<TestName>.snap". Each snapshot is identified by a
+// key made of the test name and the number of the [Match] call in the test.
+// In the file, snapshot starts with "--- <key>" line and ends at the next
+// snapshot or at the end of the file. Snapshot lines starting with "--- " are
+// written prefixed with a backslash, and one leading backslash is removed
+// from such lines when the file is read.
+//
+//	--- Test_User/valid 1
+//	User{
+//	  Name: "Alice",
+//	}
+//	--- Test_User/valid 2
+//	"Alice"
+//
+// Missing snapshots are created automatically. Snapshots are rewritten with
+// the actual values when tests are run with [golden.EnvUpdate] environment
+// variable set to true or with the "-update" flag defined by the test package
+// (see [golden.FlagUpdate]).
+package snapshot
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/dump"
+	"github.com/ctx42/testing/pkg/golden"
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// DefaultDir is the default directory for snapshot files.
+const DefaultDir = "testdata/__snapshots__"
+
+// Ext is the snapshot file extension.
+const Ext = ".snap"
+
+// Snapshot markers.
+const (
+	markerPrefix = "--- " // Snapshot marker line prefix.
+	markerEscape = `\`    // Prefix of snapshot lines which look like markers.
+)
+
+// Option represents [Match] option.
+type Option func(*Options)
+
+// WithDir is option setting the directory for snapshot files.
+func WithDir(dir string) Option {
+	return func(ops *Options) { ops.Dir = dir }
+}
+
+// WithUpdate is option making [Match] update the snapshot regardless of the
+// "-update" flag and [golden.EnvUpdate] environment variable.
+func WithUpdate(ops *Options) { ops.Update = true }
+
+// WithDump is option setting [dump.Option] options used to render values.
+func WithDump(opts ...dump.Option) Option {
+	return func(ops *Options) { ops.DumpOpts = append(ops.DumpOpts, opts...) }
+}
+
+// WithCheck is option setting [check.Option] options used when rendering and
+// comparing values.
+func WithCheck(opts ...check.Option) Option {
+	return func(ops *Options) { ops.CheckOpts = append(ops.CheckOpts, opts...) }
+}
+
+// Options represents [Match] options.
+type Options struct {
+	// Directory for snapshot files.
+	Dir string
+
+	// Update snapshots with the actual values.
+	Update bool
+
+	// Options used to render values.
+	DumpOpts []dump.Option
+
+	// Options used when rendering and comparing values.
+	CheckOpts []check.Option
+}
+
+// DefaultOptions returns default [Options].
+func DefaultOptions(opts ...Option) Options {
+	ops := Options{Dir: DefaultDir, Update: golden.Updating()}
+	for _, opt := range opts {
+		opt(&ops)
+	}
+	return ops
+}
+
+// std is the default snapshot registry.
+var std = newRegistry()
+
+// Match asserts "have" rendered with [dump.Dump] matches the snapshot. The
+// missing snapshot is created. Returns true on success, otherwise marks the
+// test as failed, writes error message to test log and returns false.
+//
+//	snapshot.Match(t, user)
+func Match(t tester.T, have any, opts ...Option) bool {
+	t.Helper()
+	if e := std.match(t, have, DefaultOptions(opts...)); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// Runner represents [testing.M].
+type Runner interface {
+	Run() int
+}
+
+// Main runs the tests and reports obsolete snapshots to standard output.
+// Snapshots are obsolete when they are in snapshot files used during the run,
+// but no test matched them. Snapshot files in [DefaultDir] and directories
+// used by [Match] calls (including their subdirectories) which were not used
+// during the run are reported as obsolete too. The report is skipped when
+// tests are filtered with "-run" or "-skip" flags. In update mode obsolete
+// snapshots and snapshot files are removed. Returns the exit code returned by
+// [Runner.Run].
+//
+//	func TestMain(m *testing.M) { os.Exit(snapshot.Main(m)) }
+func Main(m Runner) int {
+	code := m.Run()
+	if !isFiltered() {
+		err := std.obsolete(os.Stdout, golden.Updating(), DefaultDir)
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stdout, err)
+			if code == 0 {
+				code = 1
+			}
+		}
+	}
+	return code
+}
+
+// isFiltered returns true when tests are filtered with "-run" or "-skip".
+func isFiltered() bool {
+	return flagValue("test.run") != "" || flagValue("test.skip") != ""
+}
+
+// registry tracks snapshot files and snapshots used by tests.
+type registry struct {
+	mx       sync.Mutex
+	counters map[string]int             // Number of Match calls by test name.
+	files    map[string]*file           // Loaded snapshot files by path.
+	used     map[string]map[string]bool // Used snapshot keys by file path.
+	dirs     map[string]bool            // Directories used by Match calls.
+}
+
+// newRegistry returns new instance of registry.
+func newRegistry() *registry {
+	return &registry{
+		counters: make(map[string]int),
+		files:    make(map[string]*file),
+		used:     make(map[string]map[string]bool),
+		dirs:     make(map[string]bool),
+	}
+}
+
+// match compares "have" with the snapshot for the next [Match] call in the
+// test.
+func (reg *registry) match(t tester.T, have any, ops Options) error {
+	cOps := check.DefaultOptions(ops.CheckOpts...)
+	dmp := cOps.Dumper
+	for _, opt := range ops.DumpOpts {
+		opt(&dmp)
+	}
+	hStr := dmp.Any(have)
+
+	name := t.Name()
+	top, _, _ := strings.Cut(name, "/")
+	pth := filepath.Join(ops.Dir, top+Ext)
+
+	reg.mx.Lock()
+	defer reg.mx.Unlock()
+
+	if _, ok := reg.counters[name]; !ok {
+		t.Cleanup(func() {
+			reg.mx.Lock()
+			defer reg.mx.Unlock()
+			delete(reg.counters, name)
+		})
+	}
+	reg.counters[name]++
+	reg.dirs[filepath.Clean(ops.Dir)] = true
+	key := name + " " + strconv.Itoa(reg.counters[name])
+
+	fil, err := reg.load(pth)
+	if err != nil {
+		return notice.New("expected no error reading snapshot file").
+			Trail(cOps.Trail).
+			Append("path", "%s", pth).
+			Append("error", "%s", err)
+	}
+	if reg.used[pth] == nil {
+		reg.used[pth] = make(map[string]bool)
+	}
+	reg.used[pth][key] = true
+
+	want, ok := fil.entries[key]
+	if ok && (want == hStr || !ops.Update) {
+		if want == hStr {
+			return nil
+		}
+		return notice.New("expected value to match snapshot").
+			Trail(cOps.Trail).
+			Append("path", "%s", pth).
+			Append("key", "%s", key).
+			Want("%s", want).
+			Have("%s", hStr)
+	}
+
+	fil.entries[key] = hStr
+	if err = fil.write(); err != nil {
+		return notice.New("expected no error writing snapshot file").
+			Trail(cOps.Trail).
+			Append("path", "%s", pth).
+			Append("error", "%s", err)
+	}
+	return nil
+}
+
+// load returns the snapshot file at "pth". The file is read only once. When
+// the file doesn't exist, an empty one is returned.
+func (reg *registry) load(pth string) (*file, error) {
+	if fil, ok := reg.files[pth]; ok {
+		return fil, nil
+	}
+	fil := &file{pth: pth, entries: make(map[string]string)}
+	data, err := os.ReadFile(pth)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err = fil.parse(string(data)); err != nil {
+		return nil, err
+	}
+	reg.files[pth] = fil
+	return fil, nil
+}
+
+// obsolete writes the list of snapshots which were not used to "w". Snapshot
+// files in "dirs" and in directories used by [Match] calls which were not
+// used are reported too. When "remove" is true, obsolete snapshots are
+// removed from the snapshot files and obsolete snapshot files are removed.
+func (reg *registry) obsolete(w io.Writer, remove bool, dirs ...string) error {
+	reg.mx.Lock()
+	defer reg.mx.Unlock()
+
+	var ers []error
+	for _, pth := range sortedKeys(reg.files) {
+		fil := reg.files[pth]
+		var keys []string
+		for _, key := range fil.keys() {
+			if !reg.used[pth][key] {
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		verb := "obsolete"
+		if remove {
+			verb = "removed obsolete"
+			for _, key := range keys {
+				delete(fil.entries, key)
+			}
+			if err := fil.write(); err != nil {
+				ers = append(ers, err)
+			}
+		}
+		for _, key := range keys {
+			_, _ = fmt.Fprintf(w, "%s snapshot %q in %s\n", verb, key, pth)
+		}
+	}
+
+	pths, err := reg.unused(dirs)
+	if err != nil {
+		ers = append(ers, err)
+	}
+	for _, pth := range pths {
+		verb := "obsolete"
+		if remove {
+			verb = "removed obsolete"
+			if err = os.Remove(pth); err != nil {
+				ers = append(ers, err)
+				continue
+			}
+		}
+		_, _ = fmt.Fprintf(w, "%s snapshot file %s\n", verb, pth)
+	}
+	return errors.Join(ers...)
+}
+
+// unused returns sorted paths of snapshot files in "dirs" and in directories
+// used by [Match] calls (including their subdirectories) which were not used.
+// Directories which don't exist are skipped.
+func (reg *registry) unused(dirs []string) ([]string, error) {
+	roots := make(map[string]bool, len(reg.dirs)+len(dirs))
+	for dir := range reg.dirs {
+		roots[dir] = true
+	}
+	for _, dir := range dirs {
+		roots[filepath.Clean(dir)] = true
+	}
+	found := make(map[string]bool)
+	for _, root := range sortedKeys(roots) {
+		walk := func(pth string, ent fs.DirEntry, err error) error {
+			if err != nil {
+				if pth == root && errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if ent.IsDir() || filepath.Ext(pth) != Ext {
+				return nil
+			}
+			if _, ok := reg.files[pth]; !ok {
+				found[pth] = true
+			}
+			return nil
+		}
+		if err := filepath.WalkDir(root, walk); err != nil {
+			return sortedKeys(found), err
+		}
+	}
+	return sortedKeys(found), nil
+}
+
+// file represents snapshot file.
+type file struct {
+	pth     string            // Path to the file.
+	entries map[string]string // Snapshots by key.
+}
+
+// parse parses snapshot file contents.
+func (fil *file) parse(data string) error {
+	if data == "" {
+		return nil
+	}
+	var key string
+	var content []string
+	var started bool
+	flush := func() {
+		if started {
+			fil.entries[key] = strings.Join(content, "\n")
+		}
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(data, "\n"), "\n") {
+		if k, ok := strings.CutPrefix(line, markerPrefix); ok {
+			flush()
+			key, content, started = k, nil, true
+			if _, dup := fil.entries[key]; dup {
+				return errors.New("duplicate snapshot: " + key)
+			}
+			continue
+		}
+		if !started {
+			return errors.New("expected snapshot marker line")
+		}
+		if isEscaped(line) {
+			line = line[len(markerEscape):]
+		}
+		content = append(content, line)
+	}
+	flush()
+	return nil
+}
+
+// keys returns snapshot keys sorted by test name and the call number.
+func (fil *file) keys() []string {
+	keys := sortedKeys(fil.entries)
+	slices.SortFunc(keys, compareKeys)
+	return keys
+}
+
+// write writes the snapshot file. When there are no snapshots the file is
+// removed.
+func (fil *file) write() error {
+	if len(fil.entries) == 0 {
+		err := os.Remove(fil.pth)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	buf := &strings.Builder{}
+	for _, key := range fil.keys() {
+		buf.WriteString(markerPrefix + key + "\n")
+		buf.WriteString(escape(fil.entries[key]) + "\n")
+	}
+	if err := os.MkdirAll(filepath.Dir(fil.pth), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(fil.pth, []byte(buf.String()), 0o644)
+}
+
+// isEscaped returns true if the snapshot line looks like a marker prefixed
+// with one or more [markerEscape] strings.
+func isEscaped(line string) bool {
+	trimmed := strings.TrimLeft(line, markerEscape)
+	return len(trimmed) != len(line) && strings.HasPrefix(trimmed, markerPrefix)
+}
+
+// escape returns snapshot with lines which would be read as markers or as
+// escaped lines prefixed with [markerEscape].
+func escape(snap string) string {
+	lines := strings.Split(snap, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, markerPrefix) || isEscaped(line) {
+			lines[i] = markerEscape + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// compareKeys compares snapshot keys by test name and numerically by the call
+// number.
+func compareKeys(a, b string) int {
+	aName, aNum, _ := strings.Cut(a, " ")
+	bName, bNum, _ := strings.Cut(b, " ")
+	if c := strings.Compare(aName, bName); c != 0 {
+		return c
+	}
+	an, _ := strconv.Atoi(aNum)
+	bn, _ := strconv.Atoi(bNum)
+	return an - bn
+}
+
+// sortedKeys returns sorted map keys.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// flagValue returns value of the command line flag or empty string if the
+// flag is not defined.
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+	return ""
+}
@@ -0,0 +1,496 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package snapshot
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/internal/types"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/dump"
+	"github.com/ctx42/testing/pkg/golden"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_WithDir(t *testing.T) {
+	// --- Given ---
+	ops := &Options{}
+
+	// --- When ---
+	WithDir("dir")(ops)
+
+	// --- Then ---
+	affirm.Equal(t, "dir", ops.Dir)
+}
+
+func Test_WithUpdate(t *testing.T) {
+	// --- Given ---
+	ops := &Options{}
+
+	// --- When ---
+	WithUpdate(ops)
+
+	// --- Then ---
+	affirm.True(t, ops.Update)
+}
+
+func Test_WithDump(t *testing.T) {
+	// --- Given ---
+	ops := &Options{}
+
+	// --- When ---
+	WithDump(dump.WithFlat, dump.WithCompact)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, 2, len(ops.DumpOpts))
+}
+
+func Test_WithCheck(t *testing.T) {
+	// --- Given ---
+	ops := &Options{}
+
+	// --- When ---
+	WithCheck(check.WithTrail("type.field"))(ops)
+
+	// --- Then ---
+	affirm.Equal(t, 1, len(ops.CheckOpts))
+}
+
+func Test_DefaultOptions(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(golden.EnvUpdate, "")
+
+		// --- When ---
+		have := DefaultOptions()
+
+		// --- Then ---
+		affirm.Equal(t, DefaultDir, have.Dir)
+		affirm.False(t, have.Update)
+		affirm.True(t, have.DumpOpts == nil)
+		affirm.True(t, have.CheckOpts == nil)
+	})
+
+	t.Run("update from environment", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(golden.EnvUpdate, "true")
+
+		// --- When ---
+		have := DefaultOptions(WithDir("dir"))
+
+		// --- Then ---
+		affirm.Equal(t, "dir", have.Dir)
+		affirm.True(t, have.Update)
+	})
+}
+
+func Test_Match(t *testing.T) {
+	t.Setenv(golden.EnvUpdate, "")
+
+	t.Run("creates missing snapshots", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).ExpectCleanups(1).ExpectedNames(2).Close()
+		dir := t.TempDir()
+
+		// --- When ---
+		have0 := Match(tspy, types.TA{Str: "abc", Int: 1}, WithDir(dir))
+		have1 := Match(tspy, 42, WithDir(dir))
+
+		// --- Then ---
+		affirm.True(t, have0)
+		affirm.True(t, have1)
+		want := "--- Test_Match/creates_missing_snapshots 1\n" +
+			"{\n" +
+			"  Int: 1,\n" +
+			"  Str: \"abc\",\n" +
+			"  Tim: \"0001-01-01T00:00:00Z\",\n" +
+			"  Dur: \"0s\",\n" +
+			"  Loc: nil,\n" +
+			"  TAp: nil,\n" +
+			"}\n" +
+			"--- Test_Match/creates_missing_snapshots 2\n" +
+			"42\n"
+		affirm.Equal(t, want, readFile(t, filepath.Join(dir, "Test_Match.snap")))
+	})
+
+	t.Run("matches existing snapshots", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).ExpectCleanups(1).ExpectedNames(2).Close()
+		dir := t.TempDir()
+		content := "--- Test_Match/matches_existing_snapshots 1\n" +
+			"\"abc\"\n" +
+			"--- Test_Match/matches_existing_snapshots 2\n" +
+			"[]int{\n" +
+			"  1,\n" +
+			"  2,\n" +
+			"}\n"
+		writeFile(t, filepath.Join(dir, "Test_Match.snap"), content)
+
+		// --- When ---
+		have0 := Match(tspy, "abc", WithDir(dir))
+		have1 := Match(tspy, []int{1, 2}, WithDir(dir))
+
+		// --- Then ---
+		affirm.True(t, have0)
+		affirm.True(t, have1)
+	})
+
+	t.Run("dump options", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).ExpectCleanups(1).ExpectedNames(1).Close()
+		dir := t.TempDir()
+		opts := []Option{WithDir(dir), WithDump(dump.WithFlat)}
+
+		// --- When ---
+		have := Match(tspy, []int{1, 2}, opts...)
+
+		// --- Then ---
+		affirm.True(t, have)
+		want := "--- Test_Match/dump_options 1\n" +
+			"[]int{1, 2}\n"
+		affirm.Equal(t, want, readFile(t, filepath.Join(dir, "Test_Match.snap")))
+	})
+
+	t.Run("not matching", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		pth := filepath.Join(dir, "Test_Match.snap")
+		writeFile(t, pth, "--- Test_Match/not_matching 1\n\"abc\"\n")
+
+		tspy := tester.New(t).ExpectCleanups(1).ExpectedNames(1)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual(
+			"expected value to match snapshot:\n" +
+				"  trail: type.field\n" +
+				"   path: " + pth + "\n" +
+				"    key: Test_Match/not_matching 1\n" +
+				"   want: \"abc\"\n" +
+				"   have: \"xyz\"",
+		)
+		tspy.Close()
+
+		opts := []Option{
+			WithDir(dir),
+			WithCheck(check.WithTrail("type.field")),
+		}
+
+		// --- When ---
+		have := Match(tspy, "xyz", opts...)
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).ExpectCleanups(1).ExpectedNames(1).Close()
+		dir := t.TempDir()
+		pth := filepath.Join(dir, "Test_Match.snap")
+		writeFile(t, pth, "--- Test_Match/update 1\n\"abc\"\n")
+
+		// --- When ---
+		have := Match(tspy, "xyz", WithDir(dir), WithUpdate)
+
+		// --- Then ---
+		affirm.True(t, have)
+		affirm.Equal(t, "--- Test_Match/update 1\n\"xyz\"\n", readFile(t, pth))
+	})
+
+	t.Run("error invalid snapshot file", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		pth := filepath.Join(dir, "Test_Match.snap")
+		writeFile(t, pth, "abc\n")
+
+		tspy := tester.New(t).ExpectCleanups(1).ExpectedNames(1)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual(
+			"expected no error reading snapshot file:\n" +
+				"   path: " + pth + "\n" +
+				"  error: expected snapshot marker line",
+		)
+		tspy.Close()
+
+		// --- When ---
+		have := Match(tspy, "abc", WithDir(dir))
+
+		// --- Then ---
+		affirm.False(t, have)
+	})
+
+	t.Run("scrubbers", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).ExpectCleanups(1).ExpectedNames(1).Close()
+		dir := t.TempDir()
+		opts := []Option{
+			WithDir(dir),
+			WithDump(dump.WithScrubbers(dump.ScrubUUID)),
+		}
+
+		// --- When ---
+		have := Match(tspy, "7f0c5b6e-8c4a-4c7e-9d3a-2b1f0e6d5c4a", opts...)
+
+		// --- Then ---
+		affirm.True(t, have)
+		want := "--- Test_Match/scrubbers 1\n" +
+			"\"<uuid>\"\n"
+		affirm.Equal(t, want, readFile(t, filepath.Join(dir, "Test_Match.snap")))
+	})
+}
+
+func Test_registry_match(t *testing.T) {
+	t.Run("counter is reset after test", func(t *testing.T) {
+		// --- Given ---
+		reg := newRegistry()
+		ops := Options{Dir: t.TempDir()}
+
+		tspy := tester.New(t, 0).ExpectCleanups(1).ExpectedNames(2).Close()
+		affirm.Nil(t, reg.match(tspy, 1, ops))
+		affirm.Nil(t, reg.match(tspy, 2, ops))
+		tspy.Finish()
+
+		tspy = tester.New(t, 0).ExpectCleanups(1).ExpectedNames(1).Close()
+
+		// --- When ---
+		err := reg.match(tspy, 1, ops)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, 1, reg.counters[t.Name()])
+	})
+}
+
+func Test_registry_obsolete(t *testing.T) {
+	t.Run("no obsolete snapshots", func(t *testing.T) {
+		// --- Given ---
+		reg := newRegistry()
+		ops := Options{Dir: t.TempDir()}
+		tspy := tester.New(t, 0).ExpectCleanups(1).ExpectedNames(1).Close()
+		affirm.Nil(t, reg.match(tspy, 1, ops))
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := reg.obsolete(buf, false)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, "", buf.String())
+	})
+
+	t.Run("report", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		pth := filepath.Join(dir, "Test_registry_obsolete.snap")
+		content := "--- Test_registry_obsolete/report 1\n1\n" +
+			"--- Test_registry_obsolete/report 2\n2\n" +
+			"--- Test_registry_obsolete/removed 1\n3\n"
+		writeFile(t, pth, content)
+
+		reg := newRegistry()
+		tspy := tester.New(t, 0).ExpectCleanups(1).ExpectedNames(1).Close()
+		affirm.Nil(t, reg.match(tspy, 1, Options{Dir: dir}))
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := reg.obsolete(buf, false)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := "obsolete snapshot \"Test_registry_obsolete/removed 1\" in " +
+			pth + "\n" +
+			"obsolete snapshot \"Test_registry_obsolete/report 2\" in " +
+			pth + "\n"
+		affirm.Equal(t, want, buf.String())
+		affirm.Equal(t, content, readFile(t, pth))
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		pth := filepath.Join(dir, "Test_registry_obsolete.snap")
+		content := "--- Test_registry_obsolete/remove 1\n1\n" +
+			"--- Test_registry_obsolete/remove 2\n2\n"
+		writeFile(t, pth, content)
+
+		reg := newRegistry()
+		tspy := tester.New(t, 0).ExpectCleanups(1).ExpectedNames(1).Close()
+		affirm.Nil(t, reg.match(tspy, 1, Options{Dir: dir}))
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := reg.obsolete(buf, true)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := "removed obsolete snapshot " +
+			"\"Test_registry_obsolete/remove 2\" in " + pth + "\n"
+		affirm.Equal(t, want, buf.String())
+		want = "--- Test_registry_obsolete/remove 1\n1\n"
+		affirm.Equal(t, want, readFile(t, pth))
+	})
+
+	t.Run("report unused snapshot files", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		gone := filepath.Join(dir, "Test_Gone.snap")
+		writeFile(t, gone, "--- Test_Gone 1\n1\n")
+		affirm.Nil(t, os.Mkdir(filepath.Join(dir, "sub"), 0o755))
+		sub := filepath.Join(dir, "sub", "Test_Sub.snap")
+		writeFile(t, sub, "--- Test_Sub 1\n1\n")
+		writeFile(t, filepath.Join(dir, "other.txt"), "other")
+
+		reg := newRegistry()
+		tspy := tester.New(t, 0).ExpectCleanups(1).ExpectedNames(1).Close()
+		affirm.Nil(t, reg.match(tspy, 1, Options{Dir: dir}))
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := reg.obsolete(buf, false)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := "obsolete snapshot file " + gone + "\n" +
+			"obsolete snapshot file " + sub + "\n"
+		affirm.Equal(t, want, buf.String())
+		affirm.Equal(t, "--- Test_Gone 1\n1\n", readFile(t, gone))
+		affirm.Equal(t, "--- Test_Sub 1\n1\n", readFile(t, sub))
+	})
+
+	t.Run("remove unused snapshot files", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		pth := filepath.Join(dir, "Test_Gone.snap")
+		writeFile(t, pth, "--- Test_Gone 1\n1\n")
+		reg := newRegistry()
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := reg.obsolete(buf, true, dir)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, "removed obsolete snapshot file "+pth+"\n", buf.String())
+		_, err = os.Stat(pth)
+		affirm.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("directory does not exist", func(t *testing.T) {
+		// --- Given ---
+		dir := filepath.Join(t.TempDir(), "not_existing")
+		reg := newRegistry()
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := reg.obsolete(buf, false, dir)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, "", buf.String())
+	})
+}
+
+func Test_file_parse(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		fil := &file{entries: make(map[string]string)}
+
+		// --- When ---
+		err := fil.parse("")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, 0, len(fil.entries))
+	})
+
+	t.Run("escaped lines", func(t *testing.T) {
+		// --- Given ---
+		fil := &file{entries: make(map[string]string)}
+
+		// --- When ---
+		err := fil.parse("--- Test 1\n\\--- a\n\\\\--- b\n\\c\n--- Test 2\n2\n")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, 2, len(fil.entries))
+		affirm.Equal(t, "--- a\n\\--- b\n\\c", fil.entries["Test 1"])
+		affirm.Equal(t, "2", fil.entries["Test 2"])
+	})
+
+	t.Run("error duplicate snapshot", func(t *testing.T) {
+		// --- Given ---
+		fil := &file{entries: make(map[string]string)}
+
+		// --- When ---
+		err := fil.parse("--- Test 1\n1\n--- Test 1\n2\n")
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, "duplicate snapshot: Test 1", err.Error())
+	})
+}
+
+func Test_file_keys(t *testing.T) {
+	// --- Given ---
+	fil := &file{entries: map[string]string{
+		"Test_B 1":   "",
+		"Test_A 10":  "",
+		"Test_A 2":   "",
+		"Test_A/a 1": "",
+	}}
+
+	// --- When ---
+	have := fil.keys()
+
+	// --- Then ---
+	want := []string{"Test_A 2", "Test_A 10", "Test_A/a 1", "Test_B 1"}
+	affirm.DeepEqual(t, want, have)
+}
+
+func Test_file_write(t *testing.T) {
+	t.Run("marker like lines round trip", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "Test.snap")
+		fil := &file{pth: pth, entries: map[string]string{
+			"Test 1": "--- a/file.txt\n+++ b/file.txt",
+			"Test 2": "\\--- x",
+		}}
+
+		// --- When ---
+		err := fil.write()
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		want := "--- Test 1\n" +
+			"\\--- a/file.txt\n" +
+			"+++ b/file.txt\n" +
+			"--- Test 2\n" +
+			"\\\\--- x\n"
+		affirm.Equal(t, want, readFile(t, pth))
+		got := &file{entries: make(map[string]string)}
+		affirm.Nil(t, got.parse(readFile(t, pth)))
+		affirm.DeepEqual(t, fil.entries, got.entries)
+	})
+}
+
+// readFile returns contents of the file at "pth".
+func readFile(t *testing.T, pth string) string {
+	t.Helper()
+	data, err := os.ReadFile(pth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// writeFile writes the file at "pth".
+func writeFile(t *testing.T, pth, content string) {
+	t.Helper()
+	if err := os.WriteFile(pth, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}